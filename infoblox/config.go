@@ -1,7 +1,11 @@
 package infoblox
 
 import (
+	"fmt"
 	"log"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/fanatic/go-infoblox"
 )
@@ -13,13 +17,102 @@ type Config struct {
 	Username   string
 	SSLVerify  bool
 	UseCookies bool
+
+	// WAPIVersion is the version of the WAPI used for all requests, e.g. "2.3".
+	WAPIVersion string
+
+	// MaxRetries is how often a request failing with a transient error is
+	// retried, waiting from RetryMinBackoff up to RetryMaxBackoff in between.
+	MaxRetries      int
+	RetryMinBackoff time.Duration
+	RetryMaxBackoff time.Duration
+
+	// DefaultExtAttrs are extensible attributes merged into every object
+	// the provider creates or updates.
+	DefaultExtAttrs map[string]interface{}
+
+	// DefaultView and DefaultNetworkView are used by resources which leave
+	// their view or network_view empty.
+	DefaultView        string
+	DefaultNetworkView string
+}
+
+// providerMeta is handed to resources as their meta argument. Besides the
+// WAPI client it carries the provider wide settings resources need to honour.
+type providerMeta struct {
+	client             *infoblox.Client
+	defaultExtAttrs    map[string]interface{}
+	defaultView        string
+	defaultNetworkView string
+
+	extAttrTypesMu sync.Mutex
+	extAttrTypes   map[string]string
+}
+
+// go-infoblox builds the URLs of all requests from its package wide BasePath,
+// so every provider configured in the same process, such as the aliases of a
+// configuration, has to use the same WAPI version. wapiVersion is the version
+// BasePath was set to by the first provider configured.
+var (
+	wapiVersionMu sync.Mutex
+	wapiVersion   string
+)
+
+// useWAPIVersion points BasePath at the given WAPI version, unless another
+// provider already uses a different one. BasePath is only ever set once, as
+// other providers may be sending requests concurrently.
+func useWAPIVersion(version string) error {
+	version = strings.TrimPrefix(version, "v")
+
+	wapiVersionMu.Lock()
+	defer wapiVersionMu.Unlock()
+
+	if wapiVersion == "" {
+		infoblox.BasePath = wapiBasePath(version)
+		wapiVersion = version
+		return nil
+	}
+	if wapiVersion != version {
+		return fmt.Errorf("unable to use WAPI version %s, another Infoblox provider already uses WAPI version %s; "+
+			"all Infoblox providers must be configured with the same wapi_version", version, wapiVersion)
+	}
+	return nil
 }
 
 // Client returns a new client for accessing Infoblox.
 func (c *Config) Client() (*infoblox.Client, error) {
+	version := c.WAPIVersion
+	if version == "" {
+		version = defaultWAPIVersion
+	}
+
 	client := infoblox.NewClient(c.Host, c.Username, c.Password, c.SSLVerify, c.UseCookies)
+	client.HTTPClient.Transport = newRetryTransport(
+		client.HTTPClient.Transport, c.MaxRetries, c.RetryMinBackoff, c.RetryMaxBackoff)
 
-	log.Printf("[INFO] Infoblox Client configured for user: %s", client.Username)
+	if err := checkWAPIVersion(client, version); err != nil {
+		return nil, err
+	}
+	if err := useWAPIVersion(version); err != nil {
+		return nil, err
+	}
+
+	log.Printf("[INFO] Infoblox Client configured for user: %s, WAPI version: %s", client.Username, version)
 
 	return client, nil
 }
+
+// Meta returns the meta value passed to all resources and data sources.
+func (c *Config) Meta() (interface{}, error) {
+	client, err := c.Client()
+	if err != nil {
+		return nil, err
+	}
+
+	return &providerMeta{
+		client:             client,
+		defaultExtAttrs:    c.DefaultExtAttrs,
+		defaultView:        c.DefaultView,
+		defaultNetworkView: c.DefaultNetworkView,
+	}, nil
+}
@@ -0,0 +1,61 @@
+package infoblox
+
+import (
+	"testing"
+
+	infoblox "github.com/fanatic/go-infoblox"
+)
+
+// testResetWAPIVersion forgets the WAPI version configured by a test.
+func testResetWAPIVersion() func() {
+	basePath := infoblox.BasePath
+	return func() {
+		infoblox.BasePath = basePath
+		wapiVersion = ""
+	}
+}
+
+func TestConfigWAPIVersion(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+	defer testResetWAPIVersion()()
+
+	config := Config{Host: m.URL, Username: "admin", Password: "infoblox", WAPIVersion: "9.9"}
+	if _, err := config.Client(); err == nil {
+		t.Fatalf("expected an error configuring client for an unsupported WAPI version")
+	}
+
+	config.WAPIVersion = "2.7"
+	if _, err := config.Client(); err != nil {
+		t.Fatalf("error configuring client for a supported WAPI version: %s", err)
+	}
+	if infoblox.BasePath != "/wapi/v2.7/" {
+		t.Fatalf("expected requests to use /wapi/v2.7/, got %s", infoblox.BasePath)
+	}
+
+	// A second provider, such as an alias, may share the version.
+	if _, err := config.Client(); err != nil {
+		t.Fatalf("error configuring a second client for the same WAPI version: %s", err)
+	}
+}
+
+// Providers configured with different WAPI versions would overwrite each
+// other's BasePath, so the second one is refused.
+func TestConfigWAPIVersion_conflict(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+	defer testResetWAPIVersion()()
+
+	config := Config{Host: m.URL, Username: "admin", Password: "infoblox", WAPIVersion: "2.7"}
+	if _, err := config.Client(); err != nil {
+		t.Fatalf("error configuring client for a supported WAPI version: %s", err)
+	}
+
+	config.WAPIVersion = "2.3"
+	if _, err := config.Client(); err == nil {
+		t.Fatalf("expected an error configuring a second client for another WAPI version")
+	}
+	if infoblox.BasePath != "/wapi/v2.7/" {
+		t.Fatalf("expected requests to keep using /wapi/v2.7/, got %s", infoblox.BasePath)
+	}
+}
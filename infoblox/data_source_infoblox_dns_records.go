@@ -0,0 +1,168 @@
+package infoblox
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	infoblox "github.com/fanatic/go-infoblox"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// dnsRecordTypes maps the record types understood by the infoblox_dns_records
+// data source to their WAPI object type and the field exported as "value".
+var dnsRecordTypes = map[string]struct {
+	objectType string
+	valueField string
+}{
+	"A":     {"record:a", "ipv4addr"},
+	"AAAA":  {"record:aaaa", "ipv6addr"},
+	"CNAME": {"record:cname", "canonical"},
+	"MX":    {"record:mx", "exchanger"},
+	"PTR":   {"record:ptr", "ptrdname"},
+	"SRV":   {"record:srv", "target"},
+	"TXT":   {"record:txt", "text"},
+}
+
+func dataSourceInfobloxDNSRecords() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceInfobloxDNSRecordsRead,
+
+		Schema: map[string]*schema.Schema{
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"view": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"address": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"extensible_attributes": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"records": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ref": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"view": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"comment": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceInfobloxDNSRecordsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	recordType := strings.ToUpper(d.Get("type").(string))
+	t, ok := dnsRecordTypes[recordType]
+	if !ok {
+		types := make([]string, 0, len(dnsRecordTypes))
+		for k := range dnsRecordTypes {
+			types = append(types, k)
+		}
+		sort.Strings(types)
+		return fmt.Errorf("unsupported record type %q, must be one of %s", recordType, strings.Join(types, ", "))
+	}
+
+	fields := map[string]string{
+		"name": "name",
+		"zone": "zone",
+		"view": "view",
+	}
+	if attr, ok := d.GetOk("address"); ok {
+		switch recordType {
+		case "A", "AAAA":
+			fields["address"] = t.valueField
+		case "PTR":
+			addressType, err := ipType(attr.(string))
+			if err != nil {
+				return err
+			}
+			fields["address"] = addressType
+		default:
+			return fmt.Errorf("address can only be used to search A, AAAA and PTR records")
+		}
+	}
+
+	resource, err := recordResource(client, t.objectType)
+	if err != nil {
+		return err
+	}
+
+	opts := &infoblox.Options{
+		ReturnFields: []string{"name", "zone", "view", "comment", "ttl", t.valueField},
+	}
+	records, err := resource.Find(buildSearchConditions(d, fields), opts)
+	if err != nil {
+		return fmt.Errorf("error finding Infoblox %s records: %s", recordType, err)
+	}
+
+	var refs []string
+	var result []interface{}
+	for _, record := range records {
+		r := map[string]interface{}{
+			"ref":     record["_ref"],
+			"name":    record["name"],
+			"zone":    record["zone"],
+			"view":    record["view"],
+			"value":   record[t.valueField],
+			"comment": record["comment"],
+		}
+		if ttl, ok := wapiInt(record["ttl"]); ok {
+			r["ttl"] = ttl
+		}
+
+		refs = append(refs, record["_ref"].(string))
+		result = append(result, r)
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(recordType + strings.Join(refs, ","))))
+	d.Set("records", result)
+
+	return nil
+}
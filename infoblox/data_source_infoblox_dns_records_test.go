@@ -0,0 +1,44 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestMockInfobloxDNSRecords(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	m.add("record:mx", map[string]interface{}{
+		"name":      "example.com",
+		"zone":      "example.com",
+		"exchanger": "mx1.example.com",
+		"pref":      10,
+	})
+	m.add("record:mx", map[string]interface{}{
+		"name":      "example.com",
+		"zone":      "example.com",
+		"exchanger": "mx2.example.com",
+		"pref":      20,
+	})
+	m.add("record:mx", map[string]interface{}{
+		"name":      "example.org",
+		"zone":      "example.org",
+		"exchanger": "mx1.example.org",
+		"pref":      10,
+	})
+
+	r := dataSourceInfobloxDNSRecords()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"type": "MX",
+		"zone": "example.com",
+	})
+	if err := r.Read(d, m.meta()); err != nil {
+		t.Fatalf("error reading MX records: %s", err)
+	}
+
+	testMockCheckAttributes(t, d, map[string]string{
+		"records.#": "2",
+	})
+}
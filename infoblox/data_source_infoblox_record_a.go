@@ -0,0 +1,66 @@
+package infoblox
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceInfobloxRecordA() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceInfobloxARecordRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"address": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"view": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"extensible_attributes": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+			"comment": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ttl": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceInfobloxARecordRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	q := buildSearchConditions(d, map[string]string{
+		"name":    "name",
+		"address": "ipv4addr",
+		"zone":    "zone",
+		"view":    "view",
+	})
+
+	ref, err := findSingleRecord(client, "record:a", q)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(ref)
+
+	return readARecord(d, meta, setDataSourceRecordName)
+}
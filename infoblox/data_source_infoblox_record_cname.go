@@ -0,0 +1,66 @@
+package infoblox
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceInfobloxRecordCNAME() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceInfobloxCNAMERecordRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"canonical": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"view": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"extensible_attributes": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+			"comment": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ttl": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceInfobloxCNAMERecordRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	q := buildSearchConditions(d, map[string]string{
+		"name":      "name",
+		"canonical": "canonical",
+		"zone":      "zone",
+		"view":      "view",
+	})
+
+	ref, err := findSingleRecord(client, "record:cname", q)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(ref)
+
+	return readCNAMERecord(d, meta, setDataSourceRecordName)
+}
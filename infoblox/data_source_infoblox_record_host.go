@@ -0,0 +1,174 @@
+package infoblox
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// dataSourceHostIPv4Schema and dataSourceHostIPv6Schema are the computed
+// counterparts of hostIPv4Schema and hostIPv6Schema.
+func dataSourceHostIPv4Schema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"address": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"configure_for_dhcp": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"mac": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"option": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Resource{Schema: dataSourceDHCPOptionSchema()},
+		},
+		"bootfile": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"nextserver": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"use_for_ea_inheritance": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+	}
+}
+
+func dataSourceHostIPv6Schema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"address": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"configure_for_dhcp": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"mac": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"duid": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+// dataSourceDHCPOptionSchema is the computed counterpart of dhcpOptionSchema.
+func dataSourceDHCPOptionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"num": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"value": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"vendor_class": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"use_option": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+	}
+}
+
+func dataSourceInfobloxRecordHost() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceInfobloxHostRecordRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"address": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"view": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"extensible_attributes": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+			"ipv4addr": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Resource{Schema: dataSourceHostIPv4Schema()},
+			},
+			"ipv6addr": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Resource{Schema: dataSourceHostIPv6Schema()},
+			},
+			"aliases": &schema.Schema{
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"configure_for_dns": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"comment": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ttl": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceInfobloxHostRecordRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	fields := map[string]string{
+		"name": "name",
+		"zone": "zone",
+		"view": "view",
+	}
+	if attr, ok := d.GetOk("address"); ok {
+		addressType, err := ipType(attr.(string))
+		if err != nil {
+			return err
+		}
+		fields["address"] = addressType
+	}
+
+	ref, err := findSingleRecord(client, "record:host", buildSearchConditions(d, fields))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(ref)
+
+	return readHostRecord(d, meta, setDataSourceRecordName, false)
+}
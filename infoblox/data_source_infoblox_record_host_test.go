@@ -0,0 +1,61 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestMockInfobloxRecordHostDataSource(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	m.add("record:host", map[string]interface{}{
+		"name":    "pxe.example.com",
+		"aliases": []interface{}{"boot.example.com"},
+		"ipv4addrs": []interface{}{map[string]interface{}{
+			"ipv4addr":               "10.0.0.30",
+			"configure_for_dhcp":     true,
+			"mac":                    "00:50:56:00:00:30",
+			"use_bootfile":           true,
+			"bootfile":               "pxelinux.0",
+			"use_nextserver":         true,
+			"nextserver":             "10.0.0.2",
+			"use_for_ea_inheritance": true,
+			"use_options":            true,
+			"options": []interface{}{map[string]interface{}{
+				"name":         "domain-name",
+				"num":          15,
+				"value":        "example.com",
+				"vendor_class": "DHCP",
+				"use_option":   true,
+			}},
+		}},
+		"ipv6addrs": []interface{}{map[string]interface{}{
+			"ipv6addr": "2001:db8::30",
+			"duid":     "00:01:00:01:1d:2b:3c:4d:00:50:56:00:00:30",
+		}},
+		"view": "default",
+	})
+
+	r := dataSourceInfobloxRecordHost()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name": "pxe.example.com",
+	})
+	if err := r.Read(d, m.meta()); err != nil {
+		t.Fatalf("error reading host record: %s", err)
+	}
+
+	testMockCheckAttributes(t, d, map[string]string{
+		"aliases.#":                         "1",
+		"ipv4addr.0.address":                "10.0.0.30",
+		"ipv4addr.0.mac":                    "00:50:56:00:00:30",
+		"ipv4addr.0.bootfile":               "pxelinux.0",
+		"ipv4addr.0.nextserver":             "10.0.0.2",
+		"ipv4addr.0.use_for_ea_inheritance": "true",
+		"ipv4addr.0.option.0.name":          "domain-name",
+		"ipv4addr.0.option.0.num":           "15",
+		"ipv6addr.0.address":                "2001:db8::30",
+		"ipv6addr.0.duid":                   "00:01:00:01:1d:2b:3c:4d:00:50:56:00:00:30",
+	})
+}
@@ -0,0 +1,345 @@
+package infoblox
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// extAttrsSchema represents the schema for the extensible attributes of an
+// object, one block per attribute. Values are always strings in terraform and
+// are converted to the type of the attribute definition on the grid when sent
+// to WAPI.
+func extAttrsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Set:      extAttrHash,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": &schema.Schema{
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"value": &schema.Schema{
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"type": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"inheritance_source": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"descendants_action": &schema.Schema{
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"option_with_ea": &schema.Schema{
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validateStringIn("CONVERT", "INHERIT", "RETAIN"),
+							},
+							"option_without_ea": &schema.Schema{
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validateStringIn("INHERIT", "NOT_INHERIT"),
+							},
+							"option_delete_ea": &schema.Schema{
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validateStringIn("REMOVE", "RETAIN"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// extAttrDescendantsOptions lists the options of the descendants action of an
+// extensible attribute, which controls how the attribute is passed on to the
+// objects within a network or network container.
+var extAttrDescendantsOptions = []string{"option_with_ea", "option_without_ea", "option_delete_ea"}
+
+// extAttrHash hashes an extensible attribute by the arguments set on the
+// resource. The computed type and inheritance source are left out, so that
+// reading them back does not show up as a diff.
+func extAttrHash(v interface{}) int {
+	attr := v.(map[string]interface{})
+
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("%s-%s-", attr["name"].(string), attr["value"].(string)))
+	if action := extAttrDescendantsAction(attr); action != nil {
+		for _, option := range extAttrDescendantsOptions {
+			buf.WriteString(fmt.Sprintf("%v-", action[option]))
+		}
+	}
+	return hashcode.String(buf.String())
+}
+
+// extAttrDescendantsAction returns the descendants action of an extensible
+// attribute of the resource as sent to WAPI, or nil if none is set.
+func extAttrDescendantsAction(attr map[string]interface{}) map[string]interface{} {
+	actions, _ := attr["descendants_action"].([]interface{})
+	if len(actions) == 0 || actions[0] == nil {
+		return nil
+	}
+
+	result := make(map[string]interface{})
+	for _, option := range extAttrDescendantsOptions {
+		if v, _ := actions[0].(map[string]interface{})[option].(string); v != "" {
+			result[option] = v
+		}
+	}
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
+
+// extAttrType returns the type, e.g. "STRING" or "INTEGER", of the
+// extensible attribute definition with the given name. The definitions are
+// fetched from the grid once and cached for the lifetime of the provider; a
+// failed lookup is not cached, so the next one tries again.
+func (m *providerMeta) extAttrType(name string) string {
+	m.extAttrTypesMu.Lock()
+	defer m.extAttrTypesMu.Unlock()
+
+	if m.extAttrTypes == nil {
+		defs, err := wapiFind(m.client, "extensibleattributedef", nil, []string{"name", "type"})
+		if err != nil {
+			// Users without permission to read the definitions can still
+			// manage string attributes, so only warn here.
+			log.Printf("[WARN] Unable to read extensible attribute definitions, sending values as strings: %s", err)
+			return ""
+		}
+
+		types := make(map[string]string, len(defs))
+		for _, def := range defs {
+			name, _ := def["name"].(string)
+			attrType, _ := def["type"].(string)
+			types[name] = attrType
+		}
+		m.extAttrTypes = types
+	}
+
+	return m.extAttrTypes[name]
+}
+
+// buildExtAttrs merges the provider wide default extensible attributes with
+// the attributes set on a resource and converts them into the structure WAPI
+// expects for the extattrs field, e.g. {"Owner": {"value": "netops"}}.
+// Attributes set on the resource take precedence over the provider defaults.
+func buildExtAttrs(meta *providerMeta, attrs []interface{}) (map[string]interface{}, error) {
+	extAttrs := make(map[string]interface{}, len(meta.defaultExtAttrs)+len(attrs))
+	for name, value := range meta.defaultExtAttrs {
+		v, err := extAttrValue(meta, name, value.(string))
+		if err != nil {
+			return nil, err
+		}
+		extAttrs[name] = map[string]interface{}{"value": v}
+	}
+
+	for _, a := range attrs {
+		attr := a.(map[string]interface{})
+		name := attr["name"].(string)
+
+		v, err := extAttrValue(meta, name, attr["value"].(string))
+		if err != nil {
+			return nil, err
+		}
+		extAttr := map[string]interface{}{"value": v}
+		if action := extAttrDescendantsAction(attr); action != nil {
+			extAttr["descendants_action"] = action
+		}
+		extAttrs[name] = extAttr
+	}
+	return extAttrs, nil
+}
+
+// extAttrValue converts the value of an extensible attribute to the type of
+// its definition on the grid.
+func extAttrValue(meta *providerMeta, name, value string) (interface{}, error) {
+	switch meta.extAttrType(name) {
+	case "INTEGER":
+		i, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("extensible attribute %q must be an integer, got %q", name, value)
+		}
+		return i, nil
+	default:
+		return value, nil
+	}
+}
+
+// extAttrFields holds the fields of a request body which set extensible
+// attributes. It is embedded in the bodies of go-infoblox objects, or added
+// to JSON bodies with addTo.
+type extAttrFields struct {
+	// ExtAttrs replaces all extensible attributes of a new object.
+	ExtAttrs map[string]interface{} `json:"extattrs,omitempty"`
+
+	// AddExtAttrs and RemoveExtAttrs set and remove single attributes of
+	// an existing object, leaving all others alone.
+	AddExtAttrs    map[string]interface{} `json:"extattrs+,omitempty"`
+	RemoveExtAttrs map[string]interface{} `json:"extattrs-,omitempty"`
+}
+
+// addTo adds the fields to the body of a request sent with the helpers in
+// wapi.go.
+func (f extAttrFields) addTo(body map[string]interface{}) {
+	if len(f.ExtAttrs) > 0 {
+		body["extattrs"] = f.ExtAttrs
+	}
+	if len(f.AddExtAttrs) > 0 {
+		body["extattrs+"] = f.AddExtAttrs
+	}
+	if len(f.RemoveExtAttrs) > 0 {
+		body["extattrs-"] = f.RemoveExtAttrs
+	}
+}
+
+// extAttrsFromResourceData builds the extattrs fields of a create or update
+// request from the "extensible_attributes" attribute of a resource.
+// Replacing the extattrs of an existing object would drop the attributes it
+// inherits from its parent and those set outside of terraform, so updates
+// only set the configured attributes and remove the ones which were removed
+// from the configuration.
+func extAttrsFromResourceData(d *schema.ResourceData, meta interface{}) (extAttrFields, error) {
+	m := meta.(*providerMeta)
+
+	o, n := d.GetChange("extensible_attributes")
+	var oldAttrs, attrs []interface{}
+	if o != nil {
+		oldAttrs = o.(*schema.Set).List()
+	}
+	if n != nil {
+		attrs = n.(*schema.Set).List()
+	}
+
+	extAttrs, err := buildExtAttrs(m, attrs)
+	if err != nil {
+		return extAttrFields{}, err
+	}
+	if d.Id() == "" {
+		return extAttrFields{ExtAttrs: extAttrs}, nil
+	}
+
+	removed := make(map[string]interface{})
+	for _, attr := range oldAttrs {
+		name := attr.(map[string]interface{})["name"].(string)
+		if _, ok := extAttrs[name]; !ok {
+			removed[name] = map[string]interface{}{}
+		}
+	}
+	return extAttrFields{AddExtAttrs: extAttrs, RemoveExtAttrs: removed}, nil
+}
+
+// flattenExtAttrs flattens the extattrs field returned by WAPI for the
+// "extensible_attributes" attribute: a set of attribute blocks for resources,
+// and a map of strings for the data sources, which share the readers of the
+// resources.
+// Attributes which were inherited from a parent object, or which match the
+// provider wide defaults, are only kept if they are set on the resource
+// itself, so that neither shows up as a diff.
+func flattenExtAttrs(d *schema.ResourceData, meta interface{}, extAttrs interface{}) interface{} {
+	m := meta.(*providerMeta)
+
+	// WAPI does not return the descendants action, so it is carried over
+	// from the configured attribute of the same name.
+	configured := make(map[string]map[string]interface{})
+	set, isSet := d.Get("extensible_attributes").(*schema.Set)
+	if isSet {
+		for _, a := range set.List() {
+			attr := a.(map[string]interface{})
+			configured[attr["name"].(string)] = attr
+		}
+	} else if attrs, ok := d.Get("extensible_attributes").(map[string]interface{}); ok {
+		for name := range attrs {
+			configured[name] = nil
+		}
+	}
+
+	result := make(map[string]interface{})
+	var blocks []interface{}
+
+	attrs, _ := extAttrs.(map[string]interface{})
+	for name, attr := range attrs {
+		v, ok := attr.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var value string
+		switch val := v["value"].(type) {
+		case float64:
+			// WAPI returns integer attributes as JSON numbers.
+			value = strconv.FormatFloat(val, 'f', -1, 64)
+		default:
+			value = fmt.Sprintf("%v", val)
+		}
+
+		var inheritanceSource string
+		if source, ok := v["inheritance_source"]; ok {
+			if s, ok := source.(map[string]interface{}); ok {
+				inheritanceSource, _ = s["_ref"].(string)
+			}
+		}
+
+		current, ok := configured[name]
+		if !ok {
+			if _, inherited := v["inheritance_source"]; inherited {
+				continue
+			}
+			if def, ok := m.defaultExtAttrs[name]; ok && def == value {
+				continue
+			}
+		}
+
+		if !isSet {
+			result[name] = value
+			continue
+		}
+
+		block := map[string]interface{}{
+			"name":               name,
+			"value":              value,
+			"type":               m.extAttrType(name),
+			"inheritance_source": inheritanceSource,
+		}
+		if current != nil {
+			block["descendants_action"] = current["descendants_action"]
+		}
+		blocks = append(blocks, block)
+	}
+
+	if isSet {
+		return schema.NewSet(extAttrHash, blocks)
+	}
+	return result
+}
+
+// readExtAttrs reads the extensible attributes of the object with the given
+// reference into the "extensible_attributes" attribute. It is used by
+// resources whose go-infoblox object type has no extattrs field.
+func readExtAttrs(d *schema.ResourceData, meta interface{}, ref string) error {
+	client := meta.(*providerMeta).client
+
+	object, err := wapiGet(client, ref, []string{"extattrs"})
+	if err != nil {
+		return err
+	}
+
+	d.Set("extensible_attributes", flattenExtAttrs(d, meta, object["extattrs"]))
+	return nil
+}
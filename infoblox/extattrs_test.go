@@ -0,0 +1,190 @@
+package infoblox
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestMockInfobloxRecordExtAttrs(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	m.add("extensibleattributedef", map[string]interface{}{
+		"name": "Cost Center",
+		"type": "INTEGER",
+	})
+
+	r := infobloxRecordTXT()
+	p := m.provider(r)
+	p.Meta().(*providerMeta).defaultExtAttrs = map[string]interface{}{
+		"Site": "ams",
+	}
+
+	config := func(attrs ...map[string]interface{}) map[string]interface{} {
+		extAttrs := make([]interface{}, len(attrs))
+		for i, attr := range attrs {
+			extAttrs[i] = attr
+		}
+		return map[string]interface{}{
+			"name":                  "example.com",
+			"text":                  "v=spf1 -all",
+			"extensible_attributes": extAttrs,
+		}
+	}
+
+	state, err := testMockApply(t, p, nil, config(
+		map[string]interface{}{"name": "Owner", "value": "netops"},
+		map[string]interface{}{"name": "Cost Center", "value": "1234"},
+	))
+	if err != nil {
+		t.Fatalf("error creating TXT record: %s", err)
+	}
+
+	obj, _ := m.object(state.ID)
+	extAttrs := obj["extattrs"].(map[string]interface{})
+	if v := extAttrs["Cost Center"].(map[string]interface{})["value"]; v != float64(1234) {
+		t.Fatalf("expected Cost Center to be sent as an integer, got %#v", v)
+	}
+	if v := extAttrs["Site"].(map[string]interface{})["value"]; v != "ams" {
+		t.Fatalf("expected the provider default Site to be set, got %#v", v)
+	}
+
+	// Attributes inherited from a parent object are not managed by the
+	// record, unless it sets them itself.
+	const source = "network/ZG5zLm5ldHdvcmskMTAuMC4wLjAvMjQvMA:10.0.0.0/24/default"
+	m.mu.Lock()
+	m.objects[state.ID]["extattrs"].(map[string]interface{})["Region"] = map[string]interface{}{
+		"value":              "emea",
+		"inheritance_source": map[string]interface{}{"_ref": source},
+	}
+	m.objects[state.ID]["extattrs"].(map[string]interface{})["Owner"].(map[string]interface{})["inheritance_source"] = map[string]interface{}{"_ref": source}
+	m.mu.Unlock()
+
+	state = testMockRefresh(t, p, state)
+	attrs := testMockExtAttrs(r.Data(state))
+	if len(attrs) != 2 {
+		t.Fatalf("expected 2 extensible attributes, got %v", attrs)
+	}
+	if attr := attrs["Owner"]; attr["value"] != "netops" || attr["inheritance_source"] != source {
+		t.Fatalf("expected Owner to be read with its inheritance source, got %v", attr)
+	}
+	if attr := attrs["Cost Center"]; attr["value"] != "1234" || attr["type"] != "INTEGER" || attr["inheritance_source"] != "" {
+		t.Fatalf("expected Cost Center to be read as a local integer, got %v", attr)
+	}
+
+	// Updates leave inherited attributes and those set outside of
+	// terraform alone, and only remove the ones removed from the
+	// configuration.
+	m.mu.Lock()
+	m.objects[state.ID]["extattrs"].(map[string]interface{})["Ticket"] = map[string]interface{}{"value": "CHG-42"}
+	m.mu.Unlock()
+
+	state, err = testMockApply(t, p, state, config(
+		map[string]interface{}{"name": "Cost Center", "value": "4321"},
+	))
+	if err != nil {
+		t.Fatalf("error updating TXT record: %s", err)
+	}
+
+	obj, _ = m.object(state.ID)
+	extAttrs = obj["extattrs"].(map[string]interface{})
+	if _, ok := extAttrs["Owner"]; ok {
+		t.Fatalf("expected Owner to be removed from the record")
+	}
+	if v := extAttrs["Cost Center"].(map[string]interface{})["value"]; v != float64(4321) {
+		t.Fatalf("expected Cost Center to be updated, got %#v", v)
+	}
+	for _, name := range []string{"Site", "Region", "Ticket"} {
+		if _, ok := extAttrs[name]; !ok {
+			t.Fatalf("expected %s to be kept on the record", name)
+		}
+	}
+
+	if _, err := testMockApply(t, p, state, config(
+		map[string]interface{}{"name": "Cost Center", "value": "twelve"},
+	)); err == nil {
+		t.Fatalf("expected an error setting an integer attribute to a string")
+	}
+}
+
+// The descendants action of an attribute is sent along with its value, and
+// kept in the state as WAPI does not return it.
+func TestMockInfobloxNetworkExtAttrs_descendantsAction(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	r := infobloxNetwork()
+	p := m.provider(r)
+	raw := map[string]interface{}{
+		"cidr":         "10.0.0.0/24",
+		"network_view": "default",
+		"extensible_attributes": []interface{}{
+			map[string]interface{}{
+				"name":  "Owner",
+				"value": "netops",
+				"descendants_action": []interface{}{
+					map[string]interface{}{
+						"option_with_ea":    "RETAIN",
+						"option_without_ea": "INHERIT",
+					},
+				},
+			},
+		},
+	}
+
+	state, err := testMockApply(t, p, nil, raw)
+	if err != nil {
+		t.Fatalf("error creating network: %s", err)
+	}
+
+	obj, _ := m.object(state.ID)
+	owner := obj["extattrs"].(map[string]interface{})["Owner"].(map[string]interface{})
+	expected := map[string]interface{}{"option_with_ea": "RETAIN", "option_without_ea": "INHERIT"}
+	if action := owner["descendants_action"]; !reflect.DeepEqual(action, expected) {
+		t.Fatalf("expected the descendants action %v to be sent, got %#v", expected, action)
+	}
+
+	state = testMockRefresh(t, p, state)
+	diff, err := testMockPlan(t, p, state, raw)
+	if err != nil {
+		t.Fatalf("error planning network: %s", err)
+	}
+	if diff != nil && !diff.Empty() {
+		t.Fatalf("expected no changes after refreshing the network, got %#v", diff.Attributes)
+	}
+}
+
+// testMockExtAttrs returns the extensible attributes of a resource by name.
+func testMockExtAttrs(d *schema.ResourceData) map[string]map[string]interface{} {
+	attrs := make(map[string]map[string]interface{})
+	for _, a := range d.Get("extensible_attributes").(*schema.Set).List() {
+		attr := a.(map[string]interface{})
+		attrs[attr["name"].(string)] = attr
+	}
+	return attrs
+}
+
+// A failed lookup of the attribute definitions is retried by the next one.
+func TestMockInfobloxExtAttrTypes_retry(t *testing.T) {
+	m := newMockWAPI(t)
+	meta := m.meta()
+
+	m.Close()
+	if attrType := meta.extAttrType("Cost Center"); attrType != "" {
+		t.Fatalf("expected no type while the grid is unreachable, got %q", attrType)
+	}
+
+	m = newMockWAPI(t)
+	defer m.Close()
+	m.add("extensibleattributedef", map[string]interface{}{
+		"name": "Cost Center",
+		"type": "INTEGER",
+	})
+	meta.client = m.client()
+
+	if attrType := meta.extAttrType("Cost Center"); attrType != "INTEGER" {
+		t.Fatalf("expected the definitions to be read again, got type %q", attrType)
+	}
+}
@@ -4,8 +4,8 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"sort"
 	"strconv"
-	"strings"
 
 	"github.com/fanatic/go-infoblox"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -14,6 +14,8 @@ import (
 // The comment, ttl, and, view attributes are common across all of the DNS
 // record objects we deal with so far so we extract populating them into the
 // url.Values object into a helper function.
+// The view is filled in from the provider's default_view when the record is
+// created, so it is only sent again if it is changed afterwards.
 func populateSharedAttributes(d *schema.ResourceData, record *url.Values) {
 	if attr, ok := d.GetOk("comment"); ok {
 		record.Set("comment", attr.(string))
@@ -23,11 +25,89 @@ func populateSharedAttributes(d *schema.ResourceData, record *url.Values) {
 		record.Set("ttl", strconv.Itoa(attr.(int)))
 	}
 
-	if attr, ok := d.GetOk("view"); ok {
+	if attr, ok := d.GetOk("view"); ok && (d.Id() == "" || d.HasChange("view")) {
 		record.Set("view", attr.(string))
 	}
 }
 
+// populateSharedFields is the counterpart of populateSharedAttributes for the
+// record types go-infoblox does not support, which we send to WAPI as JSON
+// bodies. use_ttl is sent explicitly so that removing the ttl makes the
+// record use the TTL of its zone again.
+func populateSharedFields(d *schema.ResourceData, record map[string]interface{}) {
+	record["comment"] = d.Get("comment").(string)
+
+	if attr, ok := d.GetOk("ttl"); ok {
+		record["ttl"] = attr.(int)
+		record["use_ttl"] = true
+	} else {
+		record["use_ttl"] = false
+	}
+
+	if attr, ok := d.GetOk("view"); ok && (d.Id() == "" || d.HasChange("view")) {
+		record["view"] = attr.(string)
+	}
+}
+
+// sharedRecordReturnFields lists the fields read back by readSharedFields.
+var sharedRecordReturnFields = []string{"name", "comment", "ttl", "use_ttl", "view", "extattrs"}
+
+// readSharedFields sets the name, comment, ttl, view and extensible attributes
+// of a record read with wapiGet.
+func readSharedFields(d *schema.ResourceData, meta interface{}, record map[string]interface{}) {
+	name, _ := record["name"].(string)
+	setRecordName(d, name)
+	d.Set("view", record["view"])
+	readRecordProperties(d, meta, record)
+}
+
+// readRecordProperties sets the comment, ttl and extensible attributes of a
+// record read with wapiGet. Unlike readSharedFields it leaves the name and
+// view alone, which shared records do not have in the same form.
+func readRecordProperties(d *schema.ResourceData, meta interface{}, record map[string]interface{}) {
+	d.Set("comment", record["comment"])
+	d.Set("ttl", wapiTTL(record, "ttl", "use_ttl"))
+	d.Set("extensible_attributes", flattenExtAttrs(d, meta, record["extattrs"]))
+}
+
+// wapiInt returns a number of a WAPI response as an int. WAPI returns numbers
+// as JSON numbers, which decode to float64.
+func wapiInt(v interface{}) (int, bool) {
+	switch n := v.(type) {
+	case float64:
+		return int(n), true
+	case int:
+		return n, true
+	}
+	return 0, false
+}
+
+// wapiTTL returns the TTL in ttlField of an object read from WAPI, or 0 when
+// useField says the object does not override the TTL it inherits.
+func wapiTTL(object map[string]interface{}, ttlField, useField string) int {
+	if use, _ := object[useField].(bool); !use {
+		return 0
+	}
+	ttl, _ := wapiInt(object[ttlField])
+	return ttl
+}
+
+// Sets the view of a new resource which leaves it empty to the default_view
+// of the provider.
+func setDefaultView(d *schema.ResourceData, meta interface{}) {
+	if _, ok := d.GetOk("view"); !ok {
+		d.Set("view", meta.(*providerMeta).defaultView)
+	}
+}
+
+// Sets the network_view of a new resource which leaves it empty to the
+// default_network_view of the provider.
+func setDefaultNetworkView(d *schema.ResourceData, meta interface{}) {
+	if _, ok := d.GetOk("network_view"); !ok {
+		d.Set("network_view", meta.(*providerMeta).defaultNetworkView)
+	}
+}
+
 // Parses the given string as an ip address and returns "ipv4addr" if it is an
 // ipv4 address and "ipv6addr" if it is an ipv6 address
 func ipType(value string) (string, error) {
@@ -43,20 +123,6 @@ func ipType(value string) (string, error) {
 	return res, nil
 }
 
-// Finds networks by search term, such as network CIDR.
-func getNetworks(client *infoblox.Client, term string) ([]map[string]interface{}, error) {
-	s := "network"
-	q := []infoblox.Condition{
-		infoblox.Condition{
-			Field: &s,
-			Value: term,
-		},
-	}
-
-	network, err := client.Network().Find(q, nil)
-	return network, err
-}
-
 // Builds an array of IP addresses to exclude from terraform resource data.
 func buildExcludedAddressesArray(d *schema.ResourceData) []string {
 	var excludedAddresses []string
@@ -69,19 +135,25 @@ func buildExcludedAddressesArray(d *schema.ResourceData) []string {
 	return excludedAddresses
 }
 
-// TODO: I'm positive there's a better way to do this, but this works for now
-func getMapValueAsString(mymap map[string]interface{}, val string) string {
-	for k, v := range mymap {
-		if k == val {
-			vout := fmt.Sprintf("%q", v)
-			vout = strings.Replace(vout, "[", "", -1)
-			vout = strings.Replace(vout, "]", "", -1)
-			vout = strings.Replace(vout, "\"", "", -1)
-			return vout
+// Returns the value of the given key of a WAPI response as a list of strings,
+// such as the "ips" returned by the next_available_ip function.
+func getMapValueAsStrings(mymap map[string]interface{}, val string) []string {
+	var result []string
+
+	switch v := mymap[val].(type) {
+	case []interface{}:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				result = append(result, s)
+			}
 		}
+	case []string:
+		result = v
+	case string:
+		result = []string{v}
 	}
 
-	return ""
+	return result
 }
 
 // Validates that either 'cidr' or 'ip_range' terraform argument is set.
@@ -95,12 +167,88 @@ func validateIPData(d *schema.ResourceData) error {
 	return nil
 }
 
+// Reports whether err is the WAPI error for a reference which does not exist.
+func isNotFoundError(err error) bool {
+	infobloxErr, ok := err.(infoblox.Error)
+	return ok && infobloxErr.Code() == "Client.Ibap.Data.NotFound"
+}
+
 func handleReadError(d *schema.ResourceData, recordType string, err error) error {
-	if infobloxErr, ok := err.(infoblox.Error); ok {
-		if infobloxErr.Code() == "Client.Ibap.Data.NotFound" {
-			d.SetId("")
-			return nil
-		}
+	if isNotFoundError(err) {
+		d.SetId("")
+		return nil
 	}
 	return fmt.Errorf("Error reading Infoblox %s record: %s", recordType, err)
 }
+
+// Builds WAPI search conditions for a data source. fields maps the name of
+// each search argument in the data source schema to the WAPI field it is
+// matched against; arguments which are not set are skipped. Extensible
+// attributes given in the "extensible_attributes" map are matched exactly.
+func buildSearchConditions(d *schema.ResourceData, fields map[string]string) []infoblox.Condition {
+	var conditions []infoblox.Condition
+
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if attr, ok := d.GetOk(k); ok {
+			field := fields[k]
+			conditions = append(conditions, infoblox.Condition{
+				Field: &field,
+				Value: attr.(string),
+			})
+		}
+	}
+
+	if attr, ok := d.GetOk("extensible_attributes"); ok {
+		extAttrs := attr.(map[string]interface{})
+
+		names := make([]string, 0, len(extAttrs))
+		for name := range extAttrs {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			attribute := name
+			conditions = append(conditions, infoblox.Condition{
+				Attribute: &attribute,
+				Value:     extAttrs[name].(string),
+			})
+		}
+	}
+
+	return conditions
+}
+
+// Looks up the single record of objectType matching the given conditions and
+// returns its WAPI object reference. Data sources must identify exactly one
+// record, so finding none or several is an error.
+func findSingleRecord(client *infoblox.Client, objectType string, conditions []infoblox.Condition) (string, error) {
+	resource, err := recordResource(client, objectType)
+	if err != nil {
+		return "", err
+	}
+
+	if len(conditions) == 0 {
+		return "", fmt.Errorf("at least one search argument must be set to look up an Infoblox %s", objectType)
+	}
+
+	records, err := resource.Find(conditions, nil)
+	if err != nil {
+		return "", fmt.Errorf("error finding Infoblox %s: %s", objectType, err)
+	}
+
+	switch len(records) {
+	case 0:
+		return "", fmt.Errorf("no Infoblox %s matched the search arguments", objectType)
+	case 1:
+		return records[0]["_ref"].(string), nil
+	default:
+		return "", fmt.Errorf("%d Infoblox %s records matched the search arguments, please narrow the search", len(records), objectType)
+	}
+}
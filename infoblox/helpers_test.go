@@ -0,0 +1,43 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestWAPITTL(t *testing.T) {
+	cases := []struct {
+		Object string
+		TTL    int
+	}{
+		{`{"ttl": 300, "use_ttl": true}`, 300},
+		{`{"ttl": 300, "use_ttl": false}`, 0},
+		{`{"ttl": 300}`, 0},
+		{`{"use_ttl": true}`, 0},
+	}
+
+	for _, tc := range cases {
+		var object map[string]interface{}
+		if err := json.Unmarshal([]byte(tc.Object), &object); err != nil {
+			t.Fatalf("error decoding %s: %s", tc.Object, err)
+		}
+		if ttl := wapiTTL(object, "ttl", "use_ttl"); ttl != tc.TTL {
+			t.Fatalf("expected the TTL of %s to be %d, got %d", tc.Object, tc.TTL, ttl)
+		}
+	}
+}
+
+func TestWAPIInt(t *testing.T) {
+	if n, ok := wapiInt(float64(86400)); !ok || n != 86400 {
+		t.Fatalf("expected 86400, got %d, %v", n, ok)
+	}
+	if n, ok := wapiInt(15); !ok || n != 15 {
+		t.Fatalf("expected 15, got %d, %v", n, ok)
+	}
+	if _, ok := wapiInt("15"); ok {
+		t.Fatalf("expected a string not to be read as a number")
+	}
+	if _, ok := wapiInt(nil); ok {
+		t.Fatalf("expected a missing field not to be read as a number")
+	}
+}
@@ -0,0 +1,187 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	infoblox "github.com/fanatic/go-infoblox"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// importInfobloxRecord returns a schema.StateFunc which imports records of the
+// given WAPI object type (e.g. "record:a").
+//
+// The import ID is either the WAPI object reference of the record, such as
+// "record:a/ZG5zLmJpbmRfYSQuX2RlZmF1bHQ:foo.example.com/default", or a human
+// readable "view/fqdn/value" triple. The value is matched against valueField
+// (the address of an A record, the canonical name of a CNAME and so on) and
+// may be left off as long as view and fqdn identify a single record.
+//
+// Records managed by the deprecated infoblox_record resource can be re-homed
+// by importing them with a "<type>:<id>" ID made of the type argument and the
+// ID of the infoblox_record, after removing it from the state.
+//
+// Once the reference is known the resource's Read function populates the rest
+// of the state.
+func importInfobloxRecord(objectType, valueField string) schema.StateFunc {
+	return func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		if strings.HasPrefix(d.Id(), objectType+"/") {
+			return []*schema.ResourceData{d}, nil
+		}
+
+		ref, ok, err := parseLegacyRecordImportID(objectType, d.Id())
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			log.Printf("[DEBUG] Importing Infoblox %s %s from infoblox_record", objectType, ref)
+			d.SetId(ref)
+			return []*schema.ResourceData{d}, nil
+		}
+
+		if strings.HasPrefix(d.Id(), "record:") {
+			refType := strings.SplitN(d.Id(), "/", 2)[0]
+			return nil, fmt.Errorf("invalid import ID %q: it refers to a %s, which must be imported as %s",
+				d.Id(), refType, recordResourceName(refType))
+		}
+
+		client := meta.(*providerMeta).client
+
+		view, name, value, err := parseRecordImportID(d.Id())
+		if err != nil {
+			return nil, err
+		}
+
+		ref, err = findRecordRef(client, objectType, view, name, valueField, value)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Printf("[DEBUG] Resolved Infoblox %s import ID %q to %s", objectType, d.Id(), ref)
+		d.SetId(ref)
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// legacyRecordTypes maps the type argument of the deprecated infoblox_record
+// resource to the WAPI object type of the records it manages.
+var legacyRecordTypes = map[string]string{
+	"A":     "record:a",
+	"AAAA":  "record:aaaa",
+	"CNAME": "record:cname",
+}
+
+// parseLegacyRecordImportID parses a "<type>:<id>" import ID made of the type
+// argument and the ID of a deprecated infoblox_record resource, which is the
+// WAPI object reference of its record, and returns the reference. ok is false
+// if id is not of that form. The record must be of objectType.
+func parseLegacyRecordImportID(objectType, id string) (ref string, ok bool, err error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 {
+		return "", false, nil
+	}
+	legacyType, ok := legacyRecordTypes[strings.ToUpper(parts[0])]
+	if !ok {
+		return "", false, nil
+	}
+
+	ref = parts[1]
+	if !strings.HasPrefix(ref, legacyType+"/") {
+		return "", true, fmt.Errorf(
+			"invalid import ID %q: %q is not the ID of an infoblox_record of type %s", id, ref, parts[0])
+	}
+	if legacyType != objectType {
+		return "", true, fmt.Errorf(
+			"invalid import ID %q: infoblox_record of type %s must be imported as %s",
+			id, parts[0], recordResourceName(legacyType))
+	}
+
+	return ref, true, nil
+}
+
+// recordResourceName returns the name of the resource managing records of the
+// given WAPI object type, e.g. infoblox_record_a for record:a.
+func recordResourceName(objectType string) string {
+	return "infoblox_" + strings.Replace(objectType, ":", "_", 1)
+}
+
+// parseRecordImportID splits a "view/fqdn/value" import ID into its parts.
+// Only the first two slashes are significant so that values such as TXT
+// strings may themselves contain slashes.
+func parseRecordImportID(id string) (view, name, value string, err error) {
+	parts := strings.SplitN(id, "/", 3)
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", "", fmt.Errorf(
+			"invalid import ID %q: must be a WAPI object reference or of the form <view>/<fqdn>[/<value>]", id)
+	}
+
+	view, name = parts[0], parts[1]
+	if len(parts) == 3 {
+		value = parts[2]
+	}
+
+	return view, name, value, nil
+}
+
+// findRecordRef looks up the record of objectType matching the given view,
+// name and (optionally) value and returns its WAPI object reference.
+func findRecordRef(client *infoblox.Client, objectType, view, name, valueField, value string) (string, error) {
+	// PTR records may be identified by their address rather than by the name
+	// in the reverse zone.
+	nameField := "name"
+	if objectType == "record:ptr" {
+		if addressType, err := ipType(name); err == nil {
+			nameField = addressType
+		}
+	}
+
+	conditions := map[string]string{
+		"view":    view,
+		nameField: name,
+	}
+	if value != "" {
+		conditions[valueField] = value
+	}
+
+	records, err := wapiFind(client, objectType, conditions, nil)
+	if err != nil {
+		return "", fmt.Errorf("error finding Infoblox %s %s in view %s: %s", objectType, name, view, err)
+	}
+
+	switch len(records) {
+	case 0:
+		return "", fmt.Errorf("no Infoblox %s %s found in view %s", objectType, name, view)
+	case 1:
+		return records[0]["_ref"].(string), nil
+	default:
+		return "", fmt.Errorf(
+			"%d Infoblox %s records named %s found in view %s, import using the object reference or <view>/<fqdn>/<%s>",
+			len(records), objectType, name, view, valueField)
+	}
+}
+
+// recordResource returns the go-infoblox resource for a WAPI record type.
+func recordResource(client *infoblox.Client, objectType string) (*infoblox.Resource, error) {
+	switch objectType {
+	case "record:a":
+		return client.RecordA(), nil
+	case "record:aaaa":
+		return client.RecordAAAA(), nil
+	case "record:cname":
+		return client.RecordCname(), nil
+	case "record:host":
+		return client.RecordHost(), nil
+	case "record:mx":
+		return client.RecordMx(), nil
+	case "record:ptr":
+		return client.RecordPtr(), nil
+	case "record:srv":
+		return client.RecordSrv(), nil
+	case "record:txt":
+		return client.RecordTxt(), nil
+	}
+
+	return nil, fmt.Errorf("unsupported Infoblox record type %q", objectType)
+}
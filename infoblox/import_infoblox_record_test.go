@@ -0,0 +1,75 @@
+package infoblox
+
+import (
+	"testing"
+)
+
+func TestParseRecordImportID(t *testing.T) {
+	cases := []struct {
+		ID          string
+		View        string
+		Name        string
+		Value       string
+		ExpectError bool
+	}{
+		{"default/www.example.com/10.0.0.1", "default", "www.example.com", "10.0.0.1", false},
+		{"default/www.example.com", "default", "www.example.com", "", false},
+		{"internal/www.example.com/v=spf1 include:_spf.example.com/24 -all",
+			"internal", "www.example.com", "v=spf1 include:_spf.example.com/24 -all", false},
+		{"www.example.com", "", "", "", true},
+		{"/www.example.com/10.0.0.1", "", "", "", true},
+		{"default//10.0.0.1", "", "", "", true},
+	}
+
+	for _, tc := range cases {
+		view, name, value, err := parseRecordImportID(tc.ID)
+		if tc.ExpectError {
+			if err == nil {
+				t.Fatalf("expected error for import ID %q", tc.ID)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error for import ID %q: %s", tc.ID, err)
+		}
+		if view != tc.View || name != tc.Name || value != tc.Value {
+			t.Fatalf("import ID %q parsed as (%q, %q, %q), expected (%q, %q, %q)",
+				tc.ID, view, name, value, tc.View, tc.Name, tc.Value)
+		}
+	}
+}
+
+func TestParseLegacyRecordImportID(t *testing.T) {
+	cases := []struct {
+		ObjectType  string
+		ID          string
+		Ref         string
+		Legacy      bool
+		ExpectError bool
+	}{
+		{"record:a", "A:record:a/ZG5zLmJpbmRfYSQ:web.example.com/default",
+			"record:a/ZG5zLmJpbmRfYSQ:web.example.com/default", true, false},
+		{"record:cname", "cname:record:cname/ZG5zLmJpbmRfY25hbWUk:www.example.com/default",
+			"record:cname/ZG5zLmJpbmRfY25hbWUk:www.example.com/default", true, false},
+		{"record:a", "CNAME:record:cname/ZG5zLmJpbmRfY25hbWUk:www.example.com/default", "", true, true},
+		{"record:a", "AAAA:record:a/ZG5zLmJpbmRfYSQ:web.example.com/default", "", true, true},
+		{"record:a", "record:a/ZG5zLmJpbmRfYSQ:web.example.com/default", "", false, false},
+		{"record:a", "default/web.example.com/10.0.0.1", "", false, false},
+	}
+
+	for _, tc := range cases {
+		ref, legacy, err := parseLegacyRecordImportID(tc.ObjectType, tc.ID)
+		if tc.ExpectError {
+			if err == nil {
+				t.Fatalf("expected error for import ID %q", tc.ID)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error for import ID %q: %s", tc.ID, err)
+		}
+		if legacy != tc.Legacy || ref != tc.Ref {
+			t.Fatalf("import ID %q parsed as (%q, %t), expected (%q, %t)", tc.ID, ref, legacy, tc.Ref, tc.Legacy)
+		}
+	}
+}
@@ -0,0 +1,1015 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// mockLifecycleTests are the create, read, update and delete cycles run by
+// TestMockLifecycle. Tests of behaviour beyond that cycle live next to the
+// resource they test.
+var mockLifecycleTests = []mockLifecycleTest{
+	{
+		Name:       "DHCPRange",
+		Resource:   infobloxDHCPRange(),
+		ObjectType: "range",
+		Create: map[string]interface{}{
+			"start_addr": "10.0.0.100",
+			"end_addr":   "10.0.0.199",
+			"network":    "10.0.0.0/24",
+			"member": []interface{}{
+				map[string]interface{}{"name": "dhcp1.example.com"},
+			},
+		},
+		CreateCheck: map[string]string{
+			"start_addr":              "10.0.0.100",
+			"server_association_type": "MEMBER",
+			"member.0.name":           "dhcp1.example.com",
+		},
+		Update: map[string]interface{}{
+			"start_addr":           "10.0.0.100",
+			"end_addr":             "10.0.0.149",
+			"network":              "10.0.0.0/24",
+			"failover_association": "dhcp-failover",
+			"exclusion": []interface{}{
+				map[string]interface{}{"start_address": "10.0.0.120", "end_address": "10.0.0.129"},
+			},
+		},
+		UpdateCheck: map[string]string{
+			"end_addr":                "10.0.0.149",
+			"server_association_type": "FAILOVER",
+			"failover_association":    "dhcp-failover",
+			"member.#":                "0",
+			"exclusion.#":             "1",
+		},
+	},
+	{
+		Name:       "DNSView",
+		Resource:   infobloxDNSView(),
+		ObjectType: "view",
+		Create: map[string]interface{}{
+			"name": "internal",
+			"match_client": []interface{}{
+				map[string]interface{}{"address": "10.0.0.0/8"},
+			},
+			"recursion": true,
+		},
+		CreateCheck: map[string]string{
+			"name":                      "internal",
+			"network_view":              "default",
+			"match_client.#":            "1",
+			"match_client.0.address":    "10.0.0.0/8",
+			"match_client.0.permission": "ALLOW",
+			"recursion":                 "true",
+		},
+		Update: map[string]interface{}{
+			"name": "internal",
+			"match_client": []interface{}{
+				map[string]interface{}{"address": "10.0.0.0/8"},
+				map[string]interface{}{"address": "10.99.0.0/16", "permission": "DENY"},
+			},
+			"match_destination": []interface{}{
+				map[string]interface{}{"address": "10.0.0.53"},
+			},
+			"comment": "corporate clients",
+		},
+		UpdateCheck: map[string]string{
+			"match_client.#":            "2",
+			"match_client.1.permission": "DENY",
+			"match_destination.#":       "1",
+			"recursion":                 "false",
+			"comment":                   "corporate clients",
+		},
+	},
+	{
+		Name: "IP",
+		Setup: func(m *mockWAPI) {
+			m.add("network", map[string]interface{}{
+				"network":      "10.0.0.0/24",
+				"network_view": "default",
+			})
+		},
+		Resource:   resourceInfobloxIP(),
+		ObjectType: "fixedaddress",
+		Create: map[string]interface{}{
+			"cidr":    "10.0.0.0/24",
+			"exclude": []interface{}{"10.0.0.1"},
+		},
+		CreateCheck: map[string]string{
+			"ipaddress": "10.0.0.2",
+		},
+		Update: map[string]interface{}{
+			"cidr":    "10.0.0.0/24",
+			"exclude": []interface{}{"10.0.0.1"},
+			"comment": "load balancer VIP",
+		},
+		UpdateCheck: map[string]string{
+			"ipaddress": "10.0.0.2",
+			"comment":   "load balancer VIP",
+		},
+	},
+	{
+		Name: "IP_atomicAllocation",
+		Setup: func(m *mockWAPI) {
+			m.add("network", map[string]interface{}{
+				"network":      "10.0.0.0/24",
+				"network_view": "default",
+			})
+		},
+		Resource:   resourceInfobloxIP(),
+		ObjectType: "fixedaddress",
+		Create: map[string]interface{}{
+			"cidr":              "10.0.0.0/24",
+			"exclude":           []interface{}{"10.0.0.1", "10.0.0.2"},
+			"atomic_allocation": true,
+		},
+		CreateCheck: map[string]string{
+			"ipaddress": "10.0.0.3",
+		},
+	},
+	{
+		Name: "IP_contiguous",
+		Setup: func(m *mockWAPI) {
+			m.add("network", map[string]interface{}{
+				"network":      "10.0.0.0/24",
+				"network_view": "default",
+			})
+			for _, ip := range []string{"10.0.0.2", "10.0.0.5"} {
+				m.add("fixedaddress", map[string]interface{}{
+					"ipv4addr":     ip,
+					"network_view": "default",
+				})
+			}
+		},
+		Resource:   resourceInfobloxIP(),
+		ObjectType: "record:host",
+		Create: map[string]interface{}{
+			"cidr":          "10.0.0.0/24",
+			"num_addresses": 3,
+			"contiguous":    true,
+			"reserve_as":    "host",
+			"name":          "vips.example.com",
+		},
+		CreateCheck: map[string]string{
+			"ipaddress":   "10.0.0.6",
+			"ipaddresses": "[10.0.0.6 10.0.0.7 10.0.0.8]",
+		},
+	},
+	{
+		Name: "IP_ipv6",
+		Setup: func(m *mockWAPI) {
+			m.add("ipv6network", map[string]interface{}{
+				"network":      "2001:db8::/64",
+				"network_view": "default",
+			})
+		},
+		Resource:   resourceInfobloxIP(),
+		ObjectType: "ipv6fixedaddress",
+		Create: map[string]interface{}{
+			"cidr":       "2001:db8::/64",
+			"exclude":    []interface{}{"2001:db8::1"},
+			"reserve_as": "fixed_address",
+			"duid":       "00:01:00:01:1d:2b:3c:4d:00:50:56:00:00:01",
+		},
+		CreateCheck: map[string]string{
+			"ipaddress": "2001:db8::2",
+			"duid":      "00:01:00:01:1d:2b:3c:4d:00:50:56:00:00:01",
+		},
+		Update: map[string]interface{}{
+			"cidr":       "2001:db8::/64",
+			"exclude":    []interface{}{"2001:db8::1"},
+			"reserve_as": "fixed_address",
+			"duid":       "00:01:00:01:1d:2b:3c:4d:00:50:56:00:00:02",
+		},
+		UpdateCheck: map[string]string{
+			"ipaddress": "2001:db8::2",
+			"duid":      "00:01:00:01:1d:2b:3c:4d:00:50:56:00:00:02",
+		},
+	},
+	{
+		Name: "IP_ipv6Range",
+		Setup: func(m *mockWAPI) {
+			m.add("ipv6range", map[string]interface{}{
+				"start_addr":   "2001:db8::100",
+				"end_addr":     "2001:db8::1ff",
+				"network":      "2001:db8::/64",
+				"network_view": "default",
+			})
+		},
+		Resource:   resourceInfobloxIP(),
+		ObjectType: "record:host",
+		Create: map[string]interface{}{
+			"ip_range":   "2001:db8::100-2001:db8::1ff",
+			"reserve_as": "host",
+			"name":       "web.example.com",
+		},
+		CreateCheck: map[string]string{
+			"ipaddress": "2001:db8::100",
+		},
+	},
+	{
+		Name: "IPv6Network",
+		Setup: func(m *mockWAPI) {
+			m.add("ipv6networkcontainer", map[string]interface{}{
+				"network":      "2001:db8::/48",
+				"network_view": "default",
+			})
+		},
+		Resource:   infobloxIPv6Network(),
+		ObjectType: "ipv6network",
+		Create: map[string]interface{}{
+			"parent_cidr":   "2001:db8::/48",
+			"prefix_length": 64,
+		},
+		CreateCheck: map[string]string{
+			"cidr":         "2001:db8::/64",
+			"network_view": "default",
+		},
+		Update: map[string]interface{}{
+			"parent_cidr":   "2001:db8::/48",
+			"prefix_length": 64,
+			"comment":       "dual-stack web tier",
+		},
+		UpdateCheck: map[string]string{
+			"cidr":    "2001:db8::/64",
+			"comment": "dual-stack web tier",
+		},
+	},
+	{
+		Name: "NetworkContainer",
+		Setup: func(m *mockWAPI) {
+			m.add("networkcontainer", map[string]interface{}{
+				"network":      "10.0.0.0/8",
+				"network_view": "default",
+			})
+		},
+		Resource:   infobloxNetworkContainer(),
+		ObjectType: "networkcontainer",
+		Create: map[string]interface{}{
+			"parent_cidr":   "10.0.0.0/8",
+			"prefix_length": 16,
+		},
+		CreateCheck: map[string]string{
+			"cidr":         "10.0.0.0/16",
+			"network_view": "default",
+		},
+		Update: map[string]interface{}{
+			"parent_cidr":   "10.0.0.0/8",
+			"prefix_length": 16,
+			"comment":       "acme supernet",
+		},
+		UpdateCheck: map[string]string{
+			"cidr":    "10.0.0.0/16",
+			"comment": "acme supernet",
+		},
+	},
+	{
+		Name:       "Network",
+		Resource:   infobloxNetwork(),
+		ObjectType: "network",
+		Create: map[string]interface{}{
+			"cidr": "10.1.0.0/24",
+			"extensible_attributes": []interface{}{
+				map[string]interface{}{"name": "Owner", "value": "netops"},
+			},
+		},
+		CreateCheck: map[string]string{
+			"cidr":                    "10.1.0.0/24",
+			"network_view":            "default",
+			"extensible_attributes.#": "1",
+		},
+		Update: map[string]interface{}{
+			"cidr":    "10.1.0.0/24",
+			"comment": "app network",
+			"option": []interface{}{
+				map[string]interface{}{"name": "routers", "value": "10.1.0.1"},
+			},
+		},
+		UpdateCheck: map[string]string{
+			"comment":        "app network",
+			"option.#":       "1",
+			"option.0.value": "10.1.0.1",
+		},
+	},
+	{
+		Name: "Network_parentCIDR",
+		Setup: func(m *mockWAPI) {
+			m.add("networkcontainer", map[string]interface{}{
+				"network":      "10.20.0.0/16",
+				"network_view": "default",
+			})
+			m.add("network", map[string]interface{}{
+				"network":      "10.20.0.0/26",
+				"network_view": "default",
+			})
+		},
+		Resource:   infobloxNetwork(),
+		ObjectType: "network",
+		Create: map[string]interface{}{
+			"parent_cidr":   "10.20.0.0/16",
+			"prefix_length": 26,
+		},
+		CreateCheck: map[string]string{
+			"cidr": "10.20.0.64/26",
+		},
+	},
+	{
+		Name:       "NetworkView",
+		Resource:   infobloxNetworkView(),
+		ObjectType: "networkview",
+		Create: map[string]interface{}{
+			"name": "acme",
+		},
+		CreateCheck: map[string]string{
+			"name": "acme",
+		},
+		Update: map[string]interface{}{
+			"name":    "acme",
+			"comment": "Acme tenant",
+		},
+		UpdateCheck: map[string]string{
+			"comment": "Acme tenant",
+		},
+	},
+	{
+		Name:       "RecordA",
+		Resource:   infobloxRecordA(),
+		ObjectType: "record:a",
+		Create: map[string]interface{}{
+			"address": "10.0.0.10",
+			"name":    "web.example.com",
+			"ttl":     300,
+		},
+		CreateCheck: map[string]string{
+			"address": "10.0.0.10",
+			"name":    "web.example.com",
+			"ttl":     "300",
+			"view":    "default",
+		},
+		Update: map[string]interface{}{
+			"address": "10.0.0.10",
+			"name":    "web.example.com",
+			"ttl":     600,
+			"comment": "web server",
+		},
+		UpdateCheck: map[string]string{
+			"ttl":     "600",
+			"comment": "web server",
+		},
+	},
+	{
+		Name:       "RecordAAAA",
+		Resource:   infobloxRecordAAAA(),
+		ObjectType: "record:aaaa",
+		Create: map[string]interface{}{
+			"address": "2001:db8::10",
+			"name":    "web.example.com",
+		},
+		CreateCheck: map[string]string{
+			"address": "2001:db8::10",
+			"name":    "web.example.com",
+		},
+		Update: map[string]interface{}{
+			"address": "2001:db8::10",
+			"name":    "web.example.com",
+			"comment": "web server",
+		},
+		UpdateCheck: map[string]string{
+			"comment": "web server",
+		},
+	},
+	{
+		Name: "RecordAlias",
+		Setup: func(m *mockWAPI) {
+			m.add("zone_auth", map[string]interface{}{"fqdn": "example.com", "view": "default"})
+		},
+		Resource:   infobloxRecordAlias(),
+		ObjectType: "record:alias",
+		Create: map[string]interface{}{
+			"name":        "@",
+			"zone":        "example.com",
+			"target_name": "lb-1234.eu-west-1.elb.amazonaws.com",
+			"target_type": "A",
+		},
+		CreateCheck: map[string]string{
+			"name":        "@",
+			"target_name": "lb-1234.eu-west-1.elb.amazonaws.com",
+			"target_type": "A",
+		},
+		Update: map[string]interface{}{
+			"name":        "@",
+			"zone":        "example.com",
+			"target_name": "lb-1234.eu-west-1.elb.amazonaws.com",
+			"target_type": "AAAA",
+		},
+		UpdateCheck: map[string]string{
+			"target_type": "AAAA",
+		},
+	},
+	{
+		Name:       "RecordCAA",
+		Resource:   infobloxRecordCAA(),
+		ObjectType: "record:caa",
+		Create: map[string]interface{}{
+			"name":     "example.com",
+			"ca_tag":   "issue",
+			"ca_value": "letsencrypt.org",
+		},
+		CreateCheck: map[string]string{
+			"name":     "example.com",
+			"ca_flag":  "0",
+			"ca_tag":   "issue",
+			"ca_value": "letsencrypt.org",
+			"view":     "default",
+		},
+		Update: map[string]interface{}{
+			"name":     "example.com",
+			"ca_flag":  128,
+			"ca_tag":   "iodef",
+			"ca_value": "mailto:security@example.com",
+			"ttl":      300,
+		},
+		UpdateCheck: map[string]string{
+			"ca_flag":  "128",
+			"ca_tag":   "iodef",
+			"ca_value": "mailto:security@example.com",
+			"ttl":      "300",
+		},
+	},
+	{
+		Name:       "RecordCNAME",
+		Resource:   infobloxRecordCNAME(),
+		ObjectType: "record:cname",
+		Create: map[string]interface{}{
+			"canonical": "web.example.com",
+			"name":      "www.example.com",
+		},
+		CreateCheck: map[string]string{
+			"canonical": "web.example.com",
+			"name":      "www.example.com",
+		},
+		Update: map[string]interface{}{
+			"canonical": "web.example.com",
+			"name":      "www.example.com",
+			"ttl":       60,
+		},
+		UpdateCheck: map[string]string{
+			"ttl": "60",
+		},
+	},
+	{
+		Name:       "RecordDNAME",
+		Resource:   infobloxRecordDNAME(),
+		ObjectType: "record:dname",
+		Create: map[string]interface{}{
+			"name":   "old.example.com",
+			"target": "new.example.com",
+		},
+		CreateCheck: map[string]string{
+			"name":   "old.example.com",
+			"target": "new.example.com",
+		},
+		Update: map[string]interface{}{
+			"name":    "old.example.com",
+			"target":  "example.net",
+			"comment": "moved to example.net",
+		},
+		UpdateCheck: map[string]string{
+			"target":  "example.net",
+			"comment": "moved to example.net",
+		},
+	},
+	{
+		Name:       "RecordHost",
+		Resource:   infobloxRecordHost(),
+		ObjectType: "record:host",
+		Create: map[string]interface{}{
+			"name": "web.example.com",
+			"ipv4addr": []interface{}{
+				map[string]interface{}{"address": "10.0.0.10"},
+			},
+		},
+		CreateCheck: map[string]string{
+			"name":               "web.example.com",
+			"ipv4addr.#":         "1",
+			"ipv4addr.0.address": "10.0.0.10",
+		},
+		Update: map[string]interface{}{
+			"name": "web.example.com",
+			"ipv4addr": []interface{}{
+				map[string]interface{}{"address": "10.0.0.10"},
+				map[string]interface{}{
+					"address":            "10.0.0.11",
+					"configure_for_dhcp": true,
+					"mac":                "00:11:22:33:44:55",
+				},
+			},
+		},
+		UpdateCheck: map[string]string{
+			"ipv4addr.#":                    "2",
+			"ipv4addr.1.address":            "10.0.0.11",
+			"ipv4addr.1.configure_for_dhcp": "true",
+			"ipv4addr.1.mac":                "00:11:22:33:44:55",
+		},
+	},
+	{
+		Name:       "RecordHost_dhcp",
+		Resource:   infobloxRecordHost(),
+		ObjectType: "record:host",
+		Create: map[string]interface{}{
+			"name":    "pxe.example.com",
+			"aliases": []interface{}{"boot.example.com"},
+			"ipv4addr": []interface{}{
+				map[string]interface{}{
+					"address":            "10.0.0.20",
+					"configure_for_dhcp": true,
+					"mac":                "00:11:22:33:44:66",
+					"bootfile":           "pxelinux.0",
+					"nextserver":         "10.0.0.2",
+					"option": []interface{}{
+						map[string]interface{}{"name": "domain-name", "value": "example.com"},
+					},
+				},
+			},
+			"ipv6addr": []interface{}{
+				map[string]interface{}{
+					"address":            "2001:db8::20",
+					"configure_for_dhcp": true,
+					"duid":               "00:01:00:01:1d:2e:3f:40:00:11:22:33:44:66",
+				},
+			},
+		},
+		CreateCheck: map[string]string{
+			"aliases.#":                     "1",
+			"ipv4addr.0.bootfile":           "pxelinux.0",
+			"ipv4addr.0.nextserver":         "10.0.0.2",
+			"ipv4addr.0.option.#":           "1",
+			"ipv4addr.0.option.0.name":      "domain-name",
+			"ipv4addr.0.option.0.value":     "example.com",
+			"ipv6addr.0.duid":               "00:01:00:01:1d:2e:3f:40:00:11:22:33:44:66",
+			"ipv4addr.0.configure_for_dhcp": "true",
+		},
+		Update: map[string]interface{}{
+			"name": "pxe.example.com",
+			"ipv4addr": []interface{}{
+				map[string]interface{}{
+					"address":                "10.0.0.20",
+					"use_for_ea_inheritance": true,
+				},
+			},
+		},
+		UpdateCheck: map[string]string{
+			"aliases.#":                         "0",
+			"ipv4addr.0.bootfile":               "",
+			"ipv4addr.0.option.#":               "0",
+			"ipv4addr.0.use_for_ea_inheritance": "true",
+			"ipv6addr.#":                        "0",
+		},
+	},
+	{
+		Name: "RecordHost_nextAvailable",
+		Setup: func(m *mockWAPI) {
+			m.add("record:host", map[string]interface{}{
+				"name": "taken.example.com",
+				"ipv4addrs": []interface{}{
+					map[string]interface{}{"ipv4addr": "10.0.5.1"},
+				},
+			})
+		},
+		Resource:   infobloxRecordHost(),
+		ObjectType: "record:host",
+		Create: map[string]interface{}{
+			"name": "app.example.com",
+			"ipv4addr": []interface{}{
+				map[string]interface{}{"network": "10.0.5.0/24"},
+				map[string]interface{}{"range": "10.0.6.10-10.0.6.20"},
+			},
+		},
+		CreateCheck: map[string]string{
+			"ipv4addr.#":         "2",
+			"ipv4addr.0.address": "10.0.5.2",
+			"ipv4addr.0.network": "10.0.5.0/24",
+			"ipv4addr.1.address": "10.0.6.10",
+			"ipv4addr.1.range":   "10.0.6.10-10.0.6.20",
+		},
+	},
+	{
+		Name:       "RecordMX",
+		Resource:   infobloxRecordMX(),
+		ObjectType: "record:mx",
+		Create: map[string]interface{}{
+			"exchanger": "mail.example.com",
+			"name":      "example.com",
+			"pref":      10,
+		},
+		CreateCheck: map[string]string{
+			"exchanger": "mail.example.com",
+			"pref":      "10",
+		},
+		Update: map[string]interface{}{
+			"exchanger": "mail.example.com",
+			"name":      "example.com",
+			"pref":      20,
+		},
+		UpdateCheck: map[string]string{
+			"exchanger": "mail.example.com",
+			"pref":      "20",
+		},
+	},
+	{
+		Name:       "RecordNAPTR",
+		Resource:   infobloxRecordNAPTR(),
+		ObjectType: "record:naptr",
+		Create: map[string]interface{}{
+			"name":        "example.com",
+			"order":       10,
+			"preference":  100,
+			"flags":       "S",
+			"services":    "SIP+D2U",
+			"replacement": "_sip._udp.example.com",
+		},
+		CreateCheck: map[string]string{
+			"order":       "10",
+			"preference":  "100",
+			"flags":       "S",
+			"services":    "SIP+D2U",
+			"regexp":      "",
+			"replacement": "_sip._udp.example.com",
+		},
+		Update: map[string]interface{}{
+			"name":       "example.com",
+			"order":      10,
+			"preference": 50,
+			"flags":      "U",
+			"services":   "E2U+sip",
+			"regexp":     "!^.*$!sip:info@example.com!",
+		},
+		UpdateCheck: map[string]string{
+			"preference":  "50",
+			"flags":       "U",
+			"regexp":      "!^.*$!sip:info@example.com!",
+			"replacement": ".",
+		},
+	},
+	{
+		Name:       "RecordNS",
+		Resource:   infobloxRecordNS(),
+		ObjectType: "record:ns",
+		Create: map[string]interface{}{
+			"name":       "aws.example.com",
+			"nameserver": "ns-1.awsdns-01.org",
+			"addresses": []interface{}{
+				map[string]interface{}{"address": "205.251.192.1"},
+			},
+		},
+		CreateCheck: map[string]string{
+			"name":                        "aws.example.com",
+			"nameserver":                  "ns-1.awsdns-01.org",
+			"view":                        "default",
+			"addresses.#":                 "1",
+			"addresses.0.address":         "205.251.192.1",
+			"addresses.0.auto_create_ptr": "true",
+		},
+		Update: map[string]interface{}{
+			"name":       "aws.example.com",
+			"nameserver": "ns-1.awsdns-01.org",
+			"addresses": []interface{}{
+				map[string]interface{}{"address": "205.251.192.1", "auto_create_ptr": false},
+				map[string]interface{}{"address": "2600:9000:5300:100::1", "auto_create_ptr": false},
+			},
+		},
+		UpdateCheck: map[string]string{
+			"addresses.#":                 "2",
+			"addresses.0.auto_create_ptr": "false",
+			"addresses.1.address":         "2600:9000:5300:100::1",
+		},
+	},
+	{
+		Name:       "RecordPTR",
+		Resource:   infobloxRecordPTR(),
+		ObjectType: "record:ptr",
+		Create: map[string]interface{}{
+			"address":  "10.0.0.10",
+			"ptrdname": "web.example.com",
+		},
+		CreateCheck: map[string]string{
+			"address":  "10.0.0.10",
+			"ptrdname": "web.example.com",
+		},
+		Update: map[string]interface{}{
+			"address":  "10.0.0.10",
+			"ptrdname": "web.example.com",
+			"comment":  "web server",
+		},
+		UpdateCheck: map[string]string{
+			"comment": "web server",
+		},
+	},
+	{
+		Name:       "RecordSRV",
+		Resource:   infobloxRecordSRV(),
+		ObjectType: "record:srv",
+		Create: map[string]interface{}{
+			"name":     "_sip._tcp.example.com",
+			"port":     5060,
+			"priority": 10,
+			"weight":   5,
+			"target":   "sip.example.com",
+		},
+		CreateCheck: map[string]string{
+			"port":   "5060",
+			"target": "sip.example.com",
+		},
+		Update: map[string]interface{}{
+			"name":     "_sip._tcp.example.com",
+			"port":     5061,
+			"priority": 10,
+			"weight":   5,
+			"target":   "sip.example.com",
+		},
+		UpdateCheck: map[string]string{
+			"port": "5061",
+		},
+	},
+	{
+		Name:       "RecordTLSA",
+		Resource:   infobloxRecordTLSA(),
+		ObjectType: "record:tlsa",
+		Create: map[string]interface{}{
+			"name":              "_443._tcp.www.example.com",
+			"certificate_usage": 3,
+			"selector":          1,
+			"matched_type":      1,
+			"certificate_data":  "0c72ac70b745ac19998811b131d662c9ac69dbdbe7cb23e5b514b56664c5d3d6",
+		},
+		CreateCheck: map[string]string{
+			"name":              "_443._tcp.www.example.com",
+			"certificate_usage": "3",
+			"selector":          "1",
+			"matched_type":      "1",
+			"certificate_data":  "0c72ac70b745ac19998811b131d662c9ac69dbdbe7cb23e5b514b56664c5d3d6",
+		},
+		Update: map[string]interface{}{
+			"name":              "_443._tcp.www.example.com",
+			"certificate_usage": 2,
+			"selector":          1,
+			"matched_type":      1,
+			"certificate_data":  "0c72ac70b745ac19998811b131d662c9ac69dbdbe7cb23e5b514b56664c5d3d6",
+		},
+		UpdateCheck: map[string]string{
+			"certificate_usage": "2",
+		},
+	},
+	{
+		Name:       "RecordTXT",
+		Resource:   infobloxRecordTXT(),
+		ObjectType: "record:txt",
+		Create: map[string]interface{}{
+			"name": "example.com",
+			"text": "v=spf1 -all",
+		},
+		CreateCheck: map[string]string{
+			"name": "example.com",
+			"text": "v=spf1 -all",
+		},
+		Update: map[string]interface{}{
+			"name": "example.com",
+			"text": "v=spf1 mx -all",
+		},
+		UpdateCheck: map[string]string{
+			"text": "v=spf1 mx -all",
+		},
+	},
+	{
+		Name:       "SharedRecordGroup",
+		Resource:   infobloxSharedRecordGroup(),
+		ObjectType: "sharedrecordgroup",
+		Create: map[string]interface{}{
+			"name":  "mail",
+			"zones": []interface{}{"example.com", "example.net"},
+		},
+		CreateCheck: map[string]string{
+			"name":    "mail",
+			"view":    "default",
+			"zones.#": "2",
+		},
+		Update: map[string]interface{}{
+			"name":    "mail",
+			"zones":   []interface{}{"example.com", "example.net", "example.org"},
+			"comment": "MX and SPF of all mail domains",
+		},
+		UpdateCheck: map[string]string{
+			"zones.#": "3",
+			"comment": "MX and SPF of all mail domains",
+		},
+	},
+	{
+		Name: "SharedRecordMX",
+		Setup: func(m *mockWAPI) {
+			testMockSharedRecordGroup(m, "mail")
+		},
+		Resource:   infobloxSharedRecordMX(),
+		ObjectType: "sharedrecord:mx",
+		Create: map[string]interface{}{
+			"name":                "@",
+			"shared_record_group": "mail",
+			"exchanger":           "mx1.example.com",
+			"pref":                10,
+		},
+		CreateCheck: map[string]string{
+			"name":                "@",
+			"shared_record_group": "mail",
+			"exchanger":           "mx1.example.com",
+			"pref":                "10",
+			"ttl":                 "0",
+		},
+		Update: map[string]interface{}{
+			"name":                "@",
+			"shared_record_group": "mail",
+			"exchanger":           "mx1.example.com",
+			"pref":                20,
+			"ttl":                 3600,
+		},
+		UpdateCheck: map[string]string{
+			"pref": "20",
+			"ttl":  "3600",
+		},
+	},
+	{
+		Name: "SharedRecordTXT",
+		Setup: func(m *mockWAPI) {
+			testMockSharedRecordGroup(m, "mail")
+		},
+		Resource:   infobloxSharedRecordTXT(),
+		ObjectType: "sharedrecord:txt",
+		Create: map[string]interface{}{
+			"name":                "@",
+			"shared_record_group": "mail",
+			"text":                "v=spf1 mx -all",
+		},
+		CreateCheck: map[string]string{
+			"name": "@",
+			"text": "v=spf1 mx -all",
+		},
+		Update: map[string]interface{}{
+			"name":                "@",
+			"shared_record_group": "mail",
+			"text":                "v=spf1 mx include:_spf.example.com -all",
+		},
+		UpdateCheck: map[string]string{
+			"text": "v=spf1 mx include:_spf.example.com -all",
+		},
+	},
+	{
+		Name: "SharedRecordA",
+		Setup: func(m *mockWAPI) {
+			testMockSharedRecordGroup(m, "web")
+		},
+		Resource:   infobloxSharedRecordA(),
+		ObjectType: "sharedrecord:a",
+		Create: map[string]interface{}{
+			"name":                "www",
+			"shared_record_group": "web",
+			"address":             "10.0.0.10",
+		},
+		CreateCheck: map[string]string{
+			"name":    "www",
+			"address": "10.0.0.10",
+		},
+		Update: map[string]interface{}{
+			"name":                "www",
+			"shared_record_group": "web",
+			"address":             "10.0.0.11",
+		},
+		UpdateCheck: map[string]string{
+			"address": "10.0.0.11",
+		},
+	},
+	{
+		Name: "SharedRecordSRV",
+		Setup: func(m *mockWAPI) {
+			testMockSharedRecordGroup(m, "voip")
+		},
+		Resource:   infobloxSharedRecordSRV(),
+		ObjectType: "sharedrecord:srv",
+		Create: map[string]interface{}{
+			"name":                "_sip._tcp",
+			"shared_record_group": "voip",
+			"port":                5060,
+			"priority":            10,
+			"weight":              5,
+			"target":              "sip.example.com",
+		},
+		CreateCheck: map[string]string{
+			"port":     "5060",
+			"priority": "10",
+			"weight":   "5",
+			"target":   "sip.example.com",
+		},
+	},
+	{
+		Name:       "ZoneAuth",
+		Resource:   infobloxZoneAuth(),
+		ObjectType: "zone_auth",
+		Create: map[string]interface{}{
+			"fqdn": "example.com",
+			"grid_primary": []interface{}{
+				map[string]interface{}{"name": "ns1.example.com"},
+			},
+			"soa_refresh": 3600,
+		},
+		CreateCheck: map[string]string{
+			"fqdn":                "example.com",
+			"zone_format":         "FORWARD",
+			"view":                "default",
+			"grid_primary.#":      "1",
+			"grid_primary.0.name": "ns1.example.com",
+			"soa_refresh":         "3600",
+		},
+		Update: map[string]interface{}{
+			"fqdn": "example.com",
+			"grid_primary": []interface{}{
+				map[string]interface{}{"name": "ns1.example.com"},
+			},
+			"grid_secondary": []interface{}{
+				map[string]interface{}{"name": "ns2.example.com", "grid_replicate": true},
+			},
+			"comment": "application domain",
+		},
+		UpdateCheck: map[string]string{
+			"comment":                         "application domain",
+			"grid_secondary.#":                "1",
+			"grid_secondary.0.grid_replicate": "true",
+		},
+	},
+	{
+		Name:       "ZoneDelegated",
+		Resource:   infobloxZoneDelegated(),
+		ObjectType: "zone_delegated",
+		Create: map[string]interface{}{
+			"fqdn": "aws.example.com",
+			"delegate_to": []interface{}{
+				map[string]interface{}{"name": "ns-1.awsdns-01.org", "address": "205.251.192.1"},
+			},
+		},
+		CreateCheck: map[string]string{
+			"fqdn":                  "aws.example.com",
+			"view":                  "default",
+			"delegate_to.#":         "1",
+			"delegate_to.0.name":    "ns-1.awsdns-01.org",
+			"delegate_to.0.address": "205.251.192.1",
+			"delegated_ttl":         "0",
+		},
+		Update: map[string]interface{}{
+			"fqdn": "aws.example.com",
+			"delegate_to": []interface{}{
+				map[string]interface{}{"name": "ns-1.awsdns-01.org", "address": "205.251.192.1"},
+				map[string]interface{}{"name": "ns-2.awsdns-02.net", "address": "205.251.193.2"},
+			},
+			"delegated_ttl": 3600,
+			"comment":       "Route 53",
+		},
+		UpdateCheck: map[string]string{
+			"delegate_to.#":      "2",
+			"delegate_to.1.name": "ns-2.awsdns-02.net",
+			"delegated_ttl":      "3600",
+			"comment":            "Route 53",
+		},
+	},
+}
+
+func TestMockLifecycle(t *testing.T) {
+	for _, tc := range mockLifecycleTests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			m := newMockWAPI(t)
+			defer m.Close()
+
+			if tc.Setup != nil {
+				tc.Setup(m)
+			}
+			testMockLifecycle(t, m, tc)
+		})
+	}
+}
+
+// A retried DELETE of an object the grid had already deleted, like a
+// resource deleted outside of terraform, comes back as NotFound, which every
+// resource must take as the object being gone.
+func TestMockResourceDelete_notFound(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	for name, r := range Provider().(*schema.Provider).ResourcesMap {
+		d := r.TestResourceData()
+		if _, ok := r.Schema["type"]; ok {
+			d.Set("type", "A")
+		}
+		d.SetId("record:a/bWlzc2luZw:missing.example.com/default")
+
+		if err := r.Delete(d, m.meta()); err != nil {
+			t.Fatalf("error deleting missing %s: %s", name, err)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// infoblox_record_a and infoblox_record_aaaa can manage the PTR record of
+// their address next to the forward record when create_ptr is set. The
+// reference of the PTR is kept in ptr_ref so that it can be updated and
+// deleted along with the forward record.
+
+// reverseDNSName returns the in-addr.arpa or ip6.arpa name of an address,
+// e.g. 1.2.0.10.in-addr.arpa for 10.0.2.1.
+func reverseDNSName(address string) (string, error) {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return "", fmt.Errorf("%q is not an IP address", address)
+	}
+
+	if v4 := ip.To4(); v4 != nil {
+		return fmt.Sprintf("%d.%d.%d.%d.in-addr.arpa", v4[3], v4[2], v4[1], v4[0]), nil
+	}
+
+	const hexDigits = "0123456789abcdef"
+	labels := make([]string, 0, 2*net.IPv6len+1)
+	for i := net.IPv6len - 1; i >= 0; i-- {
+		labels = append(labels, string(hexDigits[ip[i]&0xf]), string(hexDigits[ip[i]>>4]))
+	}
+	return strings.Join(append(labels, "ip6.arpa"), "."), nil
+}
+
+// managedPTRObjectFromAttributes builds the body of a record:ptr create or
+// update request pointing the reverse name of the record's address at its
+// name. The view of an existing PTR cannot be changed, so it is only set
+// when isUpdate is false.
+func managedPTRObjectFromAttributes(d *schema.ResourceData, isUpdate bool) (map[string]interface{}, error) {
+	name, err := reverseDNSName(d.Get("address").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	ptr := map[string]interface{}{
+		"name":     name,
+		"ptrdname": recordFQDN(d),
+		"comment":  d.Get("comment").(string),
+	}
+	if attr, ok := d.GetOk("ttl"); ok {
+		ptr["ttl"] = attr.(int)
+		ptr["use_ttl"] = true
+	} else {
+		ptr["use_ttl"] = false
+	}
+	if !isUpdate {
+		ptr["view"] = d.Get("view").(string)
+	}
+
+	return ptr, nil
+}
+
+// syncManagedPTR creates, updates or deletes the PTR of a record so that it
+// matches create_ptr and the record's attributes.
+func syncManagedPTR(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+	ref := d.Get("ptr_ref").(string)
+
+	if !d.Get("create_ptr").(bool) {
+		if ref == "" {
+			return nil
+		}
+		if err := deleteManagedPTR(d, meta); err != nil {
+			return err
+		}
+		d.Set("ptr_ref", "")
+		return nil
+	}
+
+	ptr, err := managedPTRObjectFromAttributes(d, ref != "")
+	if err != nil {
+		return err
+	}
+
+	if ref == "" {
+		log.Printf("[DEBUG] Creating Infoblox PTR record with configuration: %#v", ptr)
+
+		ref, err = wapiCreate(client, "record:ptr", ptr)
+		if err != nil {
+			return fmt.Errorf("error creating Infoblox PTR record %s, is there a reverse zone for it in view %s? %s",
+				ptr["name"], d.Get("view").(string), err)
+		}
+		log.Printf("[INFO] Infoblox PTR record created with ID: %s", ref)
+	} else {
+		log.Printf("[DEBUG] Updating Infoblox PTR record with configuration: %#v", ptr)
+
+		ref, err = wapiUpdate(client, ref, ptr)
+		if err != nil {
+			return fmt.Errorf("error updating Infoblox PTR record: %s", err.Error())
+		}
+	}
+
+	d.Set("ptr_ref", ref)
+	return nil
+}
+
+// readManagedPTR checks that the PTR of a record still exists. A PTR deleted
+// outside of terraform turns create_ptr off in the state, so that the next
+// apply creates it again.
+func readManagedPTR(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+	ref := d.Get("ptr_ref").(string)
+
+	if ref == "" {
+		d.Set("create_ptr", false)
+		return nil
+	}
+
+	_, err := wapiGet(client, ref, []string{"ptrdname"})
+	if err != nil {
+		if !isNotFoundError(err) {
+			return fmt.Errorf("Error reading Infoblox PTR record: %s", err)
+		}
+		log.Printf("[WARN] Infoblox PTR record %s no longer exists", ref)
+		d.Set("ptr_ref", "")
+		d.Set("create_ptr", false)
+		return nil
+	}
+
+	d.Set("create_ptr", true)
+	return nil
+}
+
+// deleteManagedPTR deletes the PTR of a record, if it has one.
+func deleteManagedPTR(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+	ref := d.Get("ptr_ref").(string)
+
+	if ref == "" {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Deleting Infoblox PTR record: %s", ref)
+	if err := wapiDelete(client, ref); err != nil && !isNotFoundError(err) {
+		return fmt.Errorf("error deleting Infoblox PTR record: %s", err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package infoblox
+
+import (
+	"testing"
+)
+
+func TestReverseDNSName(t *testing.T) {
+	cases := []struct {
+		Address     string
+		Name        string
+		ExpectError bool
+	}{
+		{"10.0.2.1", "1.2.0.10.in-addr.arpa", false},
+		{"192.168.10.254", "254.10.168.192.in-addr.arpa", false},
+		{"2001:db8::567:89ab",
+			"b.a.9.8.7.6.5.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa", false},
+		{"www.example.com", "", true},
+	}
+
+	for _, tc := range cases {
+		name, err := reverseDNSName(tc.Address)
+		if tc.ExpectError {
+			if err == nil {
+				t.Fatalf("expected error for address %q", tc.Address)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error for address %q: %s", tc.Address, err)
+		}
+		if name != tc.Name {
+			t.Fatalf("address %q has reverse name %q, expected %q", tc.Address, name, tc.Name)
+		}
+	}
+}
@@ -0,0 +1,867 @@
+package infoblox
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	infoblox "github.com/fanatic/go-infoblox"
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// mockWAPI is an in-process fake of the Infoblox WAPI. It stores objects in
+// memory keyed by their reference and understands just enough of the API to
+// drive the provider's CRUD functions in unit tests: creating, reading,
+// searching, updating and deleting objects, the next_available_ip function
+// and the func:nextavailableip / func:nextavailablenetwork shorthands, and
+// WAPI style error payloads.
+type mockWAPI struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	objects map[string]map[string]interface{}
+	serial  int
+}
+
+var (
+	wapiPathRE = regexp.MustCompile(`^/wapi/v([^/]+)/`)
+
+	// The WAPI versions the mock grid claims to support.
+	mockWAPIVersions = []string{"1.4", "2.3", "2.7"}
+
+	// Fields sent as strings in url encoded requests which WAPI stores as
+	// numbers or booleans.
+	mockIntFields  = []string{"ttl", "port", "priority", "weight", "pref", "num"}
+	mockBoolFields = []string{"configure_for_dns", "configure_for_dhcp", "use_option"}
+)
+
+func newMockWAPI(t *testing.T) *mockWAPI {
+	m := &mockWAPI{
+		objects: make(map[string]map[string]interface{}),
+	}
+	m.Server = httptest.NewServer(m)
+	return m
+}
+
+// client returns a go-infoblox client talking to the mock.
+func (m *mockWAPI) client() *infoblox.Client {
+	return infoblox.NewClient(m.URL, "admin", "infoblox", false, false)
+}
+
+// meta returns the value the provider would hand to resources as meta when
+// configured against the mock grid.
+func (m *mockWAPI) meta() *providerMeta {
+	return &providerMeta{
+		client:             m.client(),
+		defaultView:        "default",
+		defaultNetworkView: "default",
+	}
+}
+
+// add stores an object as if it had been created outside of terraform and
+// returns its reference.
+func (m *mockWAPI) add(objectType string, fields map[string]interface{}) string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.store(objectType, fields)
+}
+
+// object returns a copy of the object with the given reference.
+func (m *mockWAPI) object(ref string) (map[string]interface{}, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	obj, ok := m.objects[ref]
+	if !ok {
+		return nil, false
+	}
+	c := make(map[string]interface{}, len(obj))
+	for k, v := range obj {
+		c[k] = v
+	}
+	return c, true
+}
+
+// count returns the number of objects of the given type.
+func (m *mockWAPI) count(objectType string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return len(m.ofType(objectType))
+}
+
+func (m *mockWAPI) ofType(objectType string) []map[string]interface{} {
+	var result []map[string]interface{}
+	for ref, obj := range m.objects {
+		if strings.HasPrefix(ref, objectType+"/") {
+			result = append(result, obj)
+		}
+	}
+	return result
+}
+
+func (m *mockWAPI) store(objectType string, fields map[string]interface{}) string {
+	m.serial++
+
+	label := ""
+	for _, k := range []string{"name", "fqdn", "network", "ipv4addr", "ipv6addr", "start_addr"} {
+		if v, ok := fields[k].(string); ok && v != "" {
+			label = v
+			break
+		}
+	}
+	if strings.HasPrefix(objectType, "record:") {
+		if _, ok := fields["view"]; !ok {
+			fields["view"] = "default"
+		}
+	}
+
+	id := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%s$%d", objectType, m.serial)))
+	ref := fmt.Sprintf("%s/%s:%s", objectType, id, label)
+	if view, ok := fields["view"].(string); ok {
+		ref += "/" + view
+	}
+
+	fields["_ref"] = ref
+	m.objects[ref] = fields
+
+	return ref
+}
+
+func (m *mockWAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	path := wapiPathRE.ReplaceAllString(r.URL.Path, "")
+	query := r.URL.Query()
+
+	if _, ok := query["_schema"]; ok {
+		m.schema(w, r.URL.Path)
+		return
+	}
+
+	switch {
+	case r.Method == "GET" && !strings.Contains(path, "/"):
+		m.find(w, path, query)
+	case r.Method == "GET":
+		m.get(w, path)
+	case r.Method == "POST" && query.Get("_function") != "":
+		m.function(w, r, path, query)
+	case r.Method == "POST":
+		m.create(w, r, path, query)
+	case r.Method == "PUT":
+		m.update(w, r, path, query)
+	case r.Method == "DELETE":
+		m.delete(w, path)
+	default:
+		writeMockError(w, http.StatusBadRequest, "Client.Ibap.Proto", "Unsupported request "+r.Method)
+	}
+}
+
+// schema answers a WAPI schema request, failing like the grid does when the
+// requested version is not supported.
+func (m *mockWAPI) schema(w http.ResponseWriter, path string) {
+	var version string
+	if match := wapiPathRE.FindStringSubmatch(path); match != nil {
+		version = match[1]
+	}
+
+	for _, v := range mockWAPIVersions {
+		if v == version {
+			writeMockJSON(w, http.StatusOK, map[string]interface{}{
+				"requested_version":  version,
+				"supported_versions": mockWAPIVersions,
+			})
+			return
+		}
+	}
+	writeMockError(w, http.StatusBadRequest, "Client.Ibap.Proto", "Unsupported WAPI version "+version)
+}
+
+func (m *mockWAPI) get(w http.ResponseWriter, ref string) {
+	obj, ok := m.objects[ref]
+	if !ok {
+		writeMockNotFound(w, ref)
+		return
+	}
+	writeMockJSON(w, http.StatusOK, obj)
+}
+
+func (m *mockWAPI) find(w http.ResponseWriter, objectType string, query url.Values) {
+	if objectType == "ipv4address" {
+		m.findIPv4Addresses(w, query)
+		return
+	}
+
+	result := []map[string]interface{}{}
+	for _, obj := range m.ofType(objectType) {
+		if mockMatches(obj, query) {
+			result = append(result, obj)
+		}
+	}
+	writeMockJSON(w, http.StatusOK, result)
+}
+
+func (m *mockWAPI) create(w http.ResponseWriter, r *http.Request, objectType string, query url.Values) {
+	fields, err := mockRequestFields(r, query)
+	if err != nil {
+		writeMockError(w, http.StatusBadRequest, "Client.Ibap.Proto.JSONDecoding", err.Error())
+		return
+	}
+
+	if err := m.resolveFunctions(fields); err != nil {
+		writeMockError(w, http.StatusBadRequest, "Client.Ibap.Data.Conflict", err.Error())
+		return
+	}
+
+	writeMockJSON(w, http.StatusCreated, m.store(objectType, fields))
+}
+
+func (m *mockWAPI) update(w http.ResponseWriter, r *http.Request, ref string, query url.Values) {
+	obj, ok := m.objects[ref]
+	if !ok {
+		writeMockNotFound(w, ref)
+		return
+	}
+
+	fields, err := mockRequestFields(r, query)
+	if err != nil {
+		writeMockError(w, http.StatusBadRequest, "Client.Ibap.Proto.JSONDecoding", err.Error())
+		return
+	}
+	for k, v := range fields {
+		obj[k] = v
+	}
+
+	// extattrs+ and extattrs- set and remove single extensible attributes.
+	if add, ok := obj["extattrs+"].(map[string]interface{}); ok {
+		extAttrs, _ := obj["extattrs"].(map[string]interface{})
+		if extAttrs == nil {
+			extAttrs = make(map[string]interface{})
+		}
+		for name, attr := range add {
+			extAttrs[name] = attr
+		}
+		obj["extattrs"] = extAttrs
+		delete(obj, "extattrs+")
+	}
+	if remove, ok := obj["extattrs-"].(map[string]interface{}); ok {
+		extAttrs, _ := obj["extattrs"].(map[string]interface{})
+		for name := range remove {
+			delete(extAttrs, name)
+		}
+		delete(obj, "extattrs-")
+	}
+
+	writeMockJSON(w, http.StatusOK, ref)
+}
+
+func (m *mockWAPI) delete(w http.ResponseWriter, ref string) {
+	if _, ok := m.objects[ref]; !ok {
+		writeMockNotFound(w, ref)
+		return
+	}
+	delete(m.objects, ref)
+
+	writeMockJSON(w, http.StatusOK, ref)
+}
+
+func (m *mockWAPI) function(w http.ResponseWriter, r *http.Request, ref string, query url.Values) {
+	obj, ok := m.objects[ref]
+	if !ok {
+		writeMockNotFound(w, ref)
+		return
+	}
+
+	args, err := mockRequestFields(r, query)
+	if err != nil {
+		writeMockError(w, http.StatusBadRequest, "Client.Ibap.Proto.JSONDecoding", err.Error())
+		return
+	}
+
+	switch query.Get("_function") {
+	case "next_available_ip":
+		num := 1
+		if n, ok := args["num"]; ok {
+			num = mockInt(n)
+		}
+		var exclude []string
+		if list, ok := args["exclude"].([]interface{}); ok {
+			for _, v := range list {
+				exclude = append(exclude, v.(string))
+			}
+		}
+
+		ips, err := m.nextAvailableIPs(mockAddressSpace(obj), exclude, num)
+		if err != nil {
+			writeMockError(w, http.StatusBadRequest, "Client.Ibap.Data", err.Error())
+			return
+		}
+		writeMockJSON(w, http.StatusOK, map[string]interface{}{"ips": ips})
+	default:
+		writeMockError(w, http.StatusBadRequest, "Client.Ibap.Proto",
+			"Function "+query.Get("_function")+" is not supported by the mock")
+	}
+}
+
+// resolveFunctions replaces func:nextavailableip values in a create request
+// with allocated addresses, like the grid does.
+func (m *mockWAPI) resolveFunctions(fields map[string]interface{}) error {
+	resolve := func(v interface{}) (interface{}, error) {
+		var (
+			target  string
+			exclude []string
+		)
+		switch f := v.(type) {
+		case string:
+			if !strings.HasPrefix(f, "func:nextavailableip:") {
+				return v, nil
+			}
+			target = strings.Split(strings.TrimPrefix(f, "func:nextavailableip:"), ",")[0]
+		case map[string]interface{}:
+			// The object function form, e.g. {"_object_function":
+			// "next_available_ip", "_object": "network", ...}.
+			if f["_object_function"] != "next_available_ip" {
+				return v, nil
+			}
+			params, _ := f["_object_parameters"].(map[string]interface{})
+			target, _ = params["network"].(string)
+			if args, ok := f["_parameters"].(map[string]interface{}); ok {
+				list, _ := args["exclude"].([]interface{})
+				for _, ip := range list {
+					exclude = append(exclude, ip.(string))
+				}
+			}
+		default:
+			return v, nil
+		}
+
+		ips, err := m.nextAvailableIPs(target, exclude, 1)
+		if err != nil {
+			return nil, err
+		}
+		return ips[0], nil
+	}
+
+	if s, ok := fields["network"].(string); ok && strings.HasPrefix(s, "func:nextavailablenetwork:") {
+		args := strings.Split(strings.TrimPrefix(s, "func:nextavailablenetwork:"), ",")
+		if len(args) != 3 {
+			return fmt.Errorf("invalid function call %s", s)
+		}
+		networks, err := m.nextAvailableNetworks(args[0], mockInt(args[2]), 1)
+		if err != nil {
+			return err
+		}
+		fields["network"] = networks[0]
+	}
+
+	for _, field := range []string{"ipv4addr", "ipv6addr"} {
+		if v, ok := fields[field]; ok {
+			resolved, err := resolve(v)
+			if err != nil {
+				return err
+			}
+			fields[field] = resolved
+		}
+
+		list, ok := fields[field+"s"].([]interface{})
+		if !ok {
+			continue
+		}
+		for _, item := range list {
+			addr, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			resolved, err := resolve(addr[field])
+			if err != nil {
+				return err
+			}
+			addr[field] = resolved
+		}
+	}
+
+	return nil
+}
+
+// usedAddresses returns every address held by an object in the mock.
+func (m *mockWAPI) usedAddresses() map[string]bool {
+	used := make(map[string]bool)
+	for _, obj := range m.objects {
+		for _, field := range []string{"ipv4addr", "ipv6addr"} {
+			if v, ok := obj[field].(string); ok {
+				used[v] = true
+			}
+			if list, ok := obj[field+"s"].([]interface{}); ok {
+				for _, item := range list {
+					if addr, ok := item.(map[string]interface{}); ok {
+						if v, ok := addr[field].(string); ok {
+							used[v] = true
+						}
+					}
+				}
+			}
+		}
+	}
+	return used
+}
+
+// nextAvailableIPs allocates num addresses from space, which is either a
+// CIDR or an "<start>-<end>" range, skipping used and excluded addresses.
+func (m *mockWAPI) nextAvailableIPs(space string, exclude []string, num int) ([]string, error) {
+	first, last, err := mockAddressBounds(space)
+	if err != nil {
+		return nil, err
+	}
+
+	skip := m.usedAddresses()
+	for _, e := range exclude {
+		skip[e] = true
+	}
+
+	var ips []string
+	for ip := first; ip.Cmp(last) <= 0 && len(ips) < num; ip = new(big.Int).Add(ip, big.NewInt(1)) {
+		s := mockIntToIP(ip, space).String()
+		if !skip[s] {
+			ips = append(ips, s)
+		}
+	}
+	if len(ips) < num {
+		return nil, fmt.Errorf("Cannot find %d available IP address(es) in %s", num, space)
+	}
+	return ips, nil
+}
+
+// nextAvailableNetworks allocates num networks of the given prefix length
+// from parent which do not overlap any network already in the mock.
+func (m *mockWAPI) nextAvailableNetworks(parent string, prefixLength, num int) ([]string, error) {
+	_, parentNet, err := net.ParseCIDR(parent)
+	if err != nil {
+		return nil, err
+	}
+	ones, bits := parentNet.Mask.Size()
+	if prefixLength < ones || prefixLength > bits {
+		return nil, fmt.Errorf("Prefix length %d is not valid for %s", prefixLength, parent)
+	}
+
+	var existing []*net.IPNet
+	for _, obj := range m.objects {
+		if network, ok := obj["network"].(string); ok && network != parentNet.String() {
+			if _, n, err := net.ParseCIDR(network); err == nil {
+				existing = append(existing, n)
+			}
+		}
+	}
+
+	first := new(big.Int).SetBytes(mockIPBytes(parentNet.IP))
+	step := new(big.Int).Lsh(big.NewInt(1), uint(bits-prefixLength))
+	count := new(big.Int).Lsh(big.NewInt(1), uint(prefixLength-ones))
+
+	var networks []string
+	for i := big.NewInt(0); i.Cmp(count) < 0 && len(networks) < num; i.Add(i, big.NewInt(1)) {
+		start := new(big.Int).Add(first, new(big.Int).Mul(i, step))
+		candidate := &net.IPNet{
+			IP:   mockIntToIP(start, parent),
+			Mask: net.CIDRMask(prefixLength, bits),
+		}
+
+		free := true
+		for _, n := range existing {
+			if n.Contains(candidate.IP) || candidate.Contains(n.IP) {
+				free = false
+				break
+			}
+		}
+		if free {
+			networks = append(networks, candidate.String())
+			existing = append(existing, candidate)
+		}
+	}
+	if len(networks) < num {
+		return nil, fmt.Errorf("Cannot find %d available /%d network(s) in %s", num, prefixLength, parent)
+	}
+	return networks, nil
+}
+
+// findIPv4Addresses emulates searching the ipv4address object by address
+// range and status, as used to find unused addresses in a range.
+func (m *mockWAPI) findIPv4Addresses(w http.ResponseWriter, query url.Values) {
+	var start, end, status string
+	for key, values := range query {
+		value := values[0]
+		// ">=" may arrive either encoded as part of the key or split at the
+		// "=" into the value, depending on how the client built the URL.
+		key = strings.TrimSuffix(key, "=")
+		value = strings.TrimPrefix(value, "=")
+		switch key {
+		case "ip_address>":
+			start = value
+		case "ip_address<":
+			end = value
+		case "status":
+			status = value
+		}
+	}
+
+	first, last, err := mockAddressBounds(start + "-" + end)
+	if err != nil {
+		writeMockError(w, http.StatusBadRequest, "Client.Ibap.Data", err.Error())
+		return
+	}
+
+	used := m.usedAddresses()
+	result := []map[string]interface{}{}
+	for ip := first; ip.Cmp(last) <= 0; ip = new(big.Int).Add(ip, big.NewInt(1)) {
+		s := mockIntToIP(ip, start).String()
+		ipStatus := "UNUSED"
+		if used[s] {
+			ipStatus = "USED"
+		}
+		if status != "" && status != ipStatus {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"_ref":       "ipv4address/" + base64.RawURLEncoding.EncodeToString([]byte(s)) + ":" + s,
+			"ip_address": s,
+			"status":     ipStatus,
+		})
+	}
+	writeMockJSON(w, http.StatusOK, result)
+}
+
+// mockAddressSpace returns the CIDR or range an object allocates from. Ranges
+// may also carry the network they belong to, so their bounds come first.
+func mockAddressSpace(obj map[string]interface{}) string {
+	if start, ok := obj["start_addr"].(string); ok {
+		return fmt.Sprintf("%s-%v", start, obj["end_addr"])
+	}
+	return fmt.Sprintf("%v", obj["network"])
+}
+
+// mockAddressBounds returns the first and last usable address of a CIDR or an
+// "<start>-<end>" range.
+func mockAddressBounds(space string) (*big.Int, *big.Int, error) {
+	if strings.Contains(space, "/") {
+		ip, network, err := net.ParseCIDR(space)
+		if err != nil {
+			return nil, nil, err
+		}
+		first := new(big.Int).SetBytes(mockIPBytes(network.IP))
+		ones, bits := network.Mask.Size()
+		size := new(big.Int).Lsh(big.NewInt(1), uint(bits-ones))
+		last := new(big.Int).Sub(new(big.Int).Add(first, size), big.NewInt(1))
+		if bits-ones > 1 {
+			// Skip the network address and, for IPv4, the broadcast address.
+			first.Add(first, big.NewInt(1))
+			if ip.To4() != nil {
+				last.Sub(last, big.NewInt(1))
+			}
+		}
+		return first, last, nil
+	}
+
+	bounds := strings.Split(space, "-")
+	if len(bounds) != 2 {
+		return nil, nil, fmt.Errorf("invalid address range %q", space)
+	}
+	start, end := net.ParseIP(bounds[0]), net.ParseIP(bounds[1])
+	if start == nil || end == nil {
+		return nil, nil, fmt.Errorf("invalid address range %q", space)
+	}
+	return new(big.Int).SetBytes(mockIPBytes(start)), new(big.Int).SetBytes(mockIPBytes(end)), nil
+}
+
+func mockIPBytes(ip net.IP) []byte {
+	if v4 := ip.To4(); v4 != nil {
+		return v4
+	}
+	return ip.To16()
+}
+
+// mockIntToIP converts i back into an address of the same family as space.
+func mockIntToIP(i *big.Int, space string) net.IP {
+	size := net.IPv6len
+	if strings.Contains(strings.Split(space, "-")[0], ".") {
+		size = net.IPv4len
+	}
+	b := i.Bytes()
+	ip := make(net.IP, size)
+	copy(ip[size-len(b):], b)
+	return ip
+}
+
+// mockMatches reports whether obj matches every search condition in query.
+func mockMatches(obj map[string]interface{}, query url.Values) bool {
+	for key, values := range query {
+		if strings.HasPrefix(key, "_") {
+			continue
+		}
+		value := values[0]
+
+		switch {
+		case strings.HasPrefix(key, "*"):
+			extAttrs, _ := obj["extattrs"].(map[string]interface{})
+			attr, _ := extAttrs[strings.TrimPrefix(key, "*")].(map[string]interface{})
+			if attr == nil || fmt.Sprintf("%v", attr["value"]) != value {
+				return false
+			}
+		case strings.HasSuffix(key, "~"):
+			re, err := regexp.Compile(value)
+			if err != nil || !re.MatchString(fmt.Sprintf("%v", obj[strings.TrimSuffix(key, "~")])) {
+				return false
+			}
+		case key == "contains_address":
+			_, network, err := net.ParseCIDR(fmt.Sprintf("%v", obj["network"]))
+			if err != nil || !network.Contains(net.ParseIP(value)) {
+				return false
+			}
+		default:
+			if !mockFieldMatches(obj, key, value) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// mockFieldMatches compares a field of obj to value. Searching a host record
+// by ipv4addr or ipv6addr matches any of its addresses.
+func mockFieldMatches(obj map[string]interface{}, field, value string) bool {
+	if v, ok := obj[field]; ok {
+		return fmt.Sprintf("%v", v) == value
+	}
+
+	if list, ok := obj[field+"s"].([]interface{}); ok {
+		for _, item := range list {
+			if addr, ok := item.(map[string]interface{}); ok && fmt.Sprintf("%v", addr[field]) == value {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// mockRequestFields merges the fields of a request, which go-infoblox sends
+// in the query string, as a url encoded form or as a JSON body.
+func mockRequestFields(r *http.Request, query url.Values) (map[string]interface{}, error) {
+	fields := make(map[string]interface{})
+
+	for k, v := range query {
+		if !strings.HasPrefix(k, "_") {
+			fields[k] = v[0]
+		}
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	body = bytes.TrimSpace(body)
+
+	if len(body) > 0 {
+		if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") || body[0] == '{' {
+			var jsonFields map[string]interface{}
+			if err := json.Unmarshal(body, &jsonFields); err != nil {
+				return nil, err
+			}
+			for k, v := range jsonFields {
+				fields[k] = v
+			}
+		} else {
+			form, err := url.ParseQuery(string(body))
+			if err != nil {
+				return nil, err
+			}
+			for k, v := range form {
+				if !strings.HasPrefix(k, "_") {
+					fields[k] = v[0]
+				}
+			}
+		}
+	}
+
+	for _, k := range mockIntFields {
+		if s, ok := fields[k].(string); ok {
+			if i, err := strconv.Atoi(s); err == nil {
+				fields[k] = i
+			}
+		}
+	}
+	for _, k := range mockBoolFields {
+		if s, ok := fields[k].(string); ok {
+			fields[k] = s == "true"
+		}
+	}
+
+	return fields, nil
+}
+
+func mockInt(v interface{}) int {
+	switch n := v.(type) {
+	case int:
+		return n
+	case float64:
+		return int(n)
+	case string:
+		i, _ := strconv.Atoi(n)
+		return i
+	}
+	return 0
+}
+
+func writeMockJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeMockError(w http.ResponseWriter, status int, code, text string) {
+	writeMockJSON(w, status, map[string]interface{}{
+		"Error": code + ": " + text,
+		"code":  code,
+		"text":  text,
+	})
+}
+
+func writeMockNotFound(w http.ResponseWriter, ref string) {
+	writeMockError(w, http.StatusNotFound, "Client.Ibap.Data.NotFound", "Reference "+ref+" not found")
+}
+
+// The TestMock tests run the resources against mockWAPI, so unlike the
+// acceptance tests they need neither TF_ACC nor a grid.
+
+// mockLifecycleTest describes a create, read, update and delete cycle of a
+// resource. Create and Update are raw configurations; CreateCheck and
+// UpdateCheck are the attribute values expected in the state afterwards.
+// Setup adds the objects the resource depends on to the grid.
+type mockLifecycleTest struct {
+	Name        string
+	Setup       func(m *mockWAPI)
+	Resource    *schema.Resource
+	ObjectType  string
+	Create      map[string]interface{}
+	CreateCheck map[string]string
+	Update      map[string]interface{}
+	UpdateCheck map[string]string
+}
+
+// mockResourceType is the type of the resource under test in the providers
+// returned by mockWAPI.provider.
+const mockResourceType = "infoblox_test"
+
+// provider returns a provider configured against the mock which only serves
+// r, so that tests can plan and apply it the way terraform does.
+func (m *mockWAPI) provider(r *schema.Resource) *schema.Provider {
+	p := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{mockResourceType: r},
+	}
+	p.SetMeta(m.meta())
+	return p
+}
+
+// testMockPlan plans raw as the configuration of the resource with the given
+// state, like terraform plan.
+func testMockPlan(t *testing.T, p *schema.Provider, state *terraform.InstanceState, raw map[string]interface{}) (*terraform.InstanceDiff, error) {
+	c, err := config.NewRawConfig(raw)
+	if err != nil {
+		t.Fatalf("error parsing configuration: %s", err)
+	}
+	return p.Diff(&terraform.InstanceInfo{Type: mockResourceType}, state, terraform.NewResourceConfig(c))
+}
+
+// testMockApply plans raw as the configuration of the resource with the given
+// state and applies the plan, like terraform apply. It returns the new state,
+// which is state itself if there is nothing to change.
+func testMockApply(t *testing.T, p *schema.Provider, state *terraform.InstanceState, raw map[string]interface{}) (*terraform.InstanceState, error) {
+	diff, err := testMockPlan(t, p, state, raw)
+	if err != nil {
+		return state, err
+	}
+	if diff == nil || diff.Empty() {
+		return state, nil
+	}
+	return p.Apply(&terraform.InstanceInfo{Type: mockResourceType}, state, diff)
+}
+
+// testMockRefresh refreshes the state of the resource, like terraform
+// refresh. It returns nil once the resource is gone.
+func testMockRefresh(t *testing.T, p *schema.Provider, state *terraform.InstanceState) *terraform.InstanceState {
+	state, err := p.Refresh(&terraform.InstanceInfo{Type: mockResourceType}, state)
+	if err != nil {
+		t.Fatalf("error refreshing %s: %s", state.ID, err)
+	}
+	return state
+}
+
+func testMockLifecycle(t *testing.T, m *mockWAPI, tc mockLifecycleTest) {
+	r := tc.Resource
+	p := m.provider(r)
+
+	state, err := testMockApply(t, p, nil, tc.Create)
+	if err != nil {
+		t.Fatalf("error creating %s: %s", tc.ObjectType, err)
+	}
+	if state == nil || state.ID == "" {
+		t.Fatalf("no ID set after creating %s", tc.ObjectType)
+	}
+	if _, ok := m.object(state.ID); !ok {
+		t.Fatalf("%s %s was not created on the grid", tc.ObjectType, state.ID)
+	}
+
+	state = testMockRefresh(t, p, state)
+	testMockCheckAttributes(t, r.Data(state), tc.CreateCheck)
+
+	if tc.Update != nil {
+		state, err = testMockApply(t, p, state, tc.Update)
+		if err != nil {
+			t.Fatalf("error updating %s: %s", tc.ObjectType, err)
+		}
+		state = testMockRefresh(t, p, state)
+		testMockCheckAttributes(t, r.Data(state), tc.UpdateCheck)
+
+		// A second plan of the same configuration has nothing to do.
+		diff, err := testMockPlan(t, p, state, tc.Update)
+		if err != nil {
+			t.Fatalf("error planning %s: %s", tc.ObjectType, err)
+		}
+		if diff != nil && !diff.Empty() {
+			t.Fatalf("expected no changes to %s after the update, got %#v", tc.ObjectType, diff.Attributes)
+		}
+	}
+
+	ref := state.ID
+	if _, err := p.Apply(&terraform.InstanceInfo{Type: mockResourceType}, state, &terraform.InstanceDiff{Destroy: true}); err != nil {
+		t.Fatalf("error deleting %s: %s", tc.ObjectType, err)
+	}
+	if _, ok := m.object(ref); ok {
+		t.Fatalf("%s %s still exists after delete", tc.ObjectType, ref)
+	}
+
+	// Refreshing a record which no longer exists removes it from the state.
+	if state = testMockRefresh(t, p, state); state != nil {
+		t.Fatalf("deleted %s was not removed from the state", tc.ObjectType)
+	}
+}
+
+func testMockCheckAttributes(t *testing.T, d *schema.ResourceData, expected map[string]string) {
+	for k, v := range expected {
+		if actual := fmt.Sprintf("%v", d.Get(k)); actual != v {
+			t.Fatalf("expected %s to be %q, got %q", k, v, actual)
+		}
+	}
+}
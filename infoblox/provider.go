@@ -1,11 +1,13 @@
 package infoblox
 
 import (
+	"time"
+
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
-//Provider returns a terraform.ResourceProvider.
+// Provider returns a terraform.ResourceProvider.
 func Provider() terraform.ResourceProvider {
 	return &schema.Provider{
 		Schema: map[string]*schema.Schema{
@@ -39,12 +41,65 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("INFOBLOX_USECOOKIES", false),
 				Description: "Use cookies",
 			},
+			"wapi_version": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("INFOBLOX_WAPI_VERSION", defaultWAPIVersion),
+				Description: "The WAPI version used for all requests",
+			},
+			"max_retries": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("INFOBLOX_MAX_RETRIES", 3),
+				Description: "How often requests failing with a transient error are retried",
+			},
+			"retry_min_backoff": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("INFOBLOX_RETRY_MIN_BACKOFF", 1),
+				Description: "Seconds to wait before the first retry, doubled for every further retry",
+			},
+			"retry_max_backoff": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("INFOBLOX_RETRY_MAX_BACKOFF", 30),
+				Description: "Maximum number of seconds to wait between retries",
+			},
+			"default_view": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("INFOBLOX_DEFAULT_VIEW", "default"),
+				Description: "The DNS view of resources which do not set one",
+			},
+			"default_network_view": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("INFOBLOX_DEFAULT_NETWORK_VIEW", "default"),
+				Description: "The network view of resources which do not set one",
+			},
+			"extensible_attributes": &schema.Schema{
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Extensible attributes set on every object managed by the provider",
+			},
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
 			"infoblox_record": resourceInfobloxRecord(),
 			"infoblox_ip":     resourceInfobloxIP(),
 
+			"infoblox_network":           infobloxNetwork(),
+			"infoblox_ipv6_network":      infobloxIPv6Network(),
+			"infoblox_network_container": infobloxNetworkContainer(),
+			"infoblox_fixed_address":     infobloxFixedAddress(),
+			"infoblox_dhcp_range":        infobloxDHCPRange(),
+
+			"infoblox_dns_view":     infobloxDNSView(),
+			"infoblox_network_view": infobloxNetworkView(),
+
+			"infoblox_zone_auth":      infobloxZoneAuth(),
+			"infoblox_zone_delegated": infobloxZoneDelegated(),
+
 			"infoblox_record_a":     infobloxRecordA(),
 			"infoblox_record_aaaa":  infobloxRecordAAAA(),
 			"infoblox_record_cname": infobloxRecordCNAME(),
@@ -53,6 +108,27 @@ func Provider() terraform.ResourceProvider {
 			"infoblox_record_txt":   infobloxRecordTXT(),
 			"infoblox_record_mx":    infobloxRecordMX(),
 			"infoblox_record_srv":   infobloxRecordSRV(),
+			"infoblox_record_ns":    infobloxRecordNS(),
+			"infoblox_record_caa":   infobloxRecordCAA(),
+			"infoblox_record_naptr": infobloxRecordNAPTR(),
+			"infoblox_record_dname": infobloxRecordDNAME(),
+			"infoblox_record_tlsa":  infobloxRecordTLSA(),
+			"infoblox_record_alias": infobloxRecordAlias(),
+
+			"infoblox_shared_record_group": infobloxSharedRecordGroup(),
+			"infoblox_shared_record_a":     infobloxSharedRecordA(),
+			"infoblox_shared_record_aaaa":  infobloxSharedRecordAAAA(),
+			"infoblox_shared_record_cname": infobloxSharedRecordCNAME(),
+			"infoblox_shared_record_mx":    infobloxSharedRecordMX(),
+			"infoblox_shared_record_srv":   infobloxSharedRecordSRV(),
+			"infoblox_shared_record_txt":   infobloxSharedRecordTXT(),
+		},
+
+		DataSourcesMap: map[string]*schema.Resource{
+			"infoblox_dns_records":  dataSourceInfobloxDNSRecords(),
+			"infoblox_record_a":     dataSourceInfobloxRecordA(),
+			"infoblox_record_cname": dataSourceInfobloxRecordCNAME(),
+			"infoblox_record_host":  dataSourceInfobloxRecordHost(),
 		},
 
 		ConfigureFunc: provideConfigure,
@@ -66,7 +142,16 @@ func provideConfigure(d *schema.ResourceData) (interface{}, error) {
 		Host:       d.Get("host").(string),
 		SSLVerify:  d.Get("sslverify").(bool),
 		UseCookies: d.Get("usecookies").(bool),
+
+		WAPIVersion:     d.Get("wapi_version").(string),
+		MaxRetries:      d.Get("max_retries").(int),
+		RetryMinBackoff: time.Duration(d.Get("retry_min_backoff").(int)) * time.Second,
+		RetryMaxBackoff: time.Duration(d.Get("retry_max_backoff").(int)) * time.Second,
+		DefaultExtAttrs: d.Get("extensible_attributes").(map[string]interface{}),
+
+		DefaultView:        d.Get("default_view").(string),
+		DefaultNetworkView: d.Get("default_network_view").(string),
 	}
 
-	return config.Client()
+	return config.Meta()
 }
@@ -0,0 +1,104 @@
+package infoblox
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Records with a zone take their name relative to it: "www" in the zone
+// example.com is www.example.com, "@" is the apex of the zone and names
+// ending with a dot are taken as they are. Records without a zone keep
+// taking their name as a FQDN.
+
+// relativeRecordName returns fqdn relative to zone: "@" for the apex of the
+// zone, the labels in front of the zone for names inside it and fqdn itself
+// for names outside of it.
+func relativeRecordName(fqdn, zone string) string {
+	fqdn = strings.TrimSuffix(fqdn, ".")
+	zone = strings.TrimSuffix(zone, ".")
+
+	if strings.EqualFold(fqdn, zone) {
+		return "@"
+	}
+
+	suffix := "." + zone
+	if len(fqdn) > len(suffix) && strings.EqualFold(fqdn[len(fqdn)-len(suffix):], suffix) {
+		return fqdn[:len(fqdn)-len(suffix)]
+	}
+	return fqdn
+}
+
+// recordFQDN returns the FQDN of the record as sent to WAPI.
+func recordFQDN(d *schema.ResourceData) string {
+	name := d.Get("name").(string)
+	zone := strings.TrimSuffix(d.Get("zone").(string), ".")
+
+	switch {
+	case zone == "":
+		return name
+	case name == "@":
+		return zone
+	case strings.HasSuffix(name, "."):
+		return strings.TrimSuffix(name, ".")
+	}
+	return name + "." + zone
+}
+
+// setRecordName sets the name of a record read from WAPI, relative to its
+// zone if it has one. Names configured with a trailing dot are left alone
+// as long as they still match.
+func setRecordName(d *schema.ResourceData, fqdn string) {
+	zone := d.Get("zone").(string)
+	if zone == "" {
+		d.Set("name", fqdn)
+		return
+	}
+
+	name := d.Get("name").(string)
+	if strings.HasSuffix(name, ".") && strings.EqualFold(strings.TrimSuffix(name, "."), fqdn) {
+		return
+	}
+	d.Set("name", relativeRecordName(fqdn, zone))
+}
+
+// setDataSourceRecordName sets the name of a record found by a data source.
+// The zone of a data source only narrows down the search, so the name is
+// always the FQDN.
+func setDataSourceRecordName(d *schema.ResourceData, fqdn string) {
+	d.Set("name", fqdn)
+}
+
+// validateRecordZone checks that the zone of a record is an authoritative
+// zone in the view of the record and that the record's name falls inside it.
+func validateRecordZone(d *schema.ResourceData, meta interface{}) error {
+	name := d.Get("name").(string)
+	zone := strings.TrimSuffix(d.Get("zone").(string), ".")
+	view := d.Get("view").(string)
+
+	if zone == "" {
+		if name == "@" {
+			return fmt.Errorf("'zone' must be set to use '@' as the name of a record")
+		}
+		return nil
+	}
+
+	if absolute := strings.TrimSuffix(name, "."); absolute != name && relativeRecordName(absolute, zone) == absolute {
+		return fmt.Errorf("record %s is not inside zone %s", name, zone)
+	}
+
+	client := meta.(*providerMeta).client
+	zones, err := wapiFind(client, "zone_auth", map[string]string{
+		"fqdn": zone,
+		"view": view,
+	}, nil)
+	if err != nil {
+		return fmt.Errorf("error finding Infoblox zone %s: %s", zone, err)
+	}
+	if len(zones) != 1 {
+		return fmt.Errorf("expected one Infoblox zone %s in view %s, found %d", zone, view, len(zones))
+	}
+
+	return nil
+}
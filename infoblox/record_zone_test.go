@@ -0,0 +1,75 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestRelativeRecordName(t *testing.T) {
+	cases := []struct {
+		FQDN string
+		Zone string
+		Name string
+	}{
+		{"www.example.com", "example.com", "www"},
+		{"www.eu.example.com", "example.com", "www.eu"},
+		{"example.com", "example.com", "@"},
+		{"WWW.Example.com", "example.com.", "WWW"},
+		{"www.example.org", "example.com", "www.example.org"},
+		{"wwwexample.com", "example.com", "wwwexample.com"},
+	}
+
+	for _, tc := range cases {
+		if name := relativeRecordName(tc.FQDN, tc.Zone); name != tc.Name {
+			t.Fatalf("%q in zone %q is %q, expected %q", tc.FQDN, tc.Zone, name, tc.Name)
+		}
+	}
+}
+
+// The zone of a data source only narrows down the search, so the name of the
+// record found is its FQDN rather than relative to the zone.
+func TestMockInfobloxRecordDataSources_zone(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	m.add("record:a", map[string]interface{}{
+		"name":     "web.example.com",
+		"ipv4addr": "10.0.0.10",
+		"zone":     "example.com",
+		"view":     "default",
+	})
+	m.add("record:cname", map[string]interface{}{
+		"name":      "www.example.com",
+		"canonical": "web.example.com",
+		"zone":      "example.com",
+		"view":      "default",
+	})
+	m.add("record:host", map[string]interface{}{
+		"name":      "db.example.com",
+		"ipv4addrs": []interface{}{map[string]interface{}{"ipv4addr": "10.0.0.20"}},
+		"zone":      "example.com",
+		"view":      "default",
+	})
+
+	cases := []struct {
+		Resource *schema.Resource
+		Search   map[string]interface{}
+		Name     string
+	}{
+		{dataSourceInfobloxRecordA(), map[string]interface{}{"address": "10.0.0.10", "zone": "example.com"}, "web.example.com"},
+		{dataSourceInfobloxRecordCNAME(), map[string]interface{}{"canonical": "web.example.com", "zone": "example.com"}, "www.example.com"},
+		{dataSourceInfobloxRecordHost(), map[string]interface{}{"zone": "example.com"}, "db.example.com"},
+	}
+
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, tc.Resource.Schema, tc.Search)
+		if err := tc.Resource.Read(d, m.meta()); err != nil {
+			t.Fatalf("error reading %s: %s", tc.Name, err)
+		}
+		testMockCheckAttributes(t, d, map[string]string{
+			"name": tc.Name,
+			"zone": "example.com",
+		})
+	}
+}
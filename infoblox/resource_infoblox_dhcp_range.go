@@ -0,0 +1,380 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"strings"
+
+	infoblox "github.com/fanatic/go-infoblox"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// dhcpExclusionSchema represents the schema for a range excluded from a DHCP range
+func dhcpExclusionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"start_address": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateIPAddress,
+		},
+		"end_address": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateIPAddress,
+		},
+		"comment": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+}
+
+func infobloxDHCPRange() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInfobloxDHCPRangeCreate,
+		Read:   resourceInfobloxDHCPRangeRead,
+		Update: resourceInfobloxDHCPRangeUpdate,
+		Delete: resourceInfobloxDHCPRangeDelete,
+		Importer: &schema.ResourceImporter{
+			State: importInfobloxDHCPRange,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"start_addr": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateIPAddress,
+			},
+			"end_addr": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateIPAddress,
+			},
+			"network": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCIDR,
+			},
+			"network_view": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"comment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"server_association_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"member": &schema.Schema{
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				Elem:          &schema.Resource{Schema: dhcpMemberSchema()},
+				ConflictsWith: []string{"failover_association"},
+			},
+			"failover_association": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"member"},
+			},
+			"exclusion": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Resource{Schema: dhcpExclusionSchema()},
+			},
+			"option": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Resource{Schema: dhcpOptionSchema()},
+			},
+			"extensible_attributes": extAttrsSchema(),
+		},
+	}
+}
+
+// dhcpRangeReturnFields lists the fields we read back for DHCP ranges.
+var dhcpRangeReturnFields = []string{
+	"start_addr", "end_addr", "network", "network_view", "name", "comment",
+	"server_association_type", "member", "failover_association", "exclude", "options", "extattrs",
+}
+
+// Validates the addresses of the range and that the server association type
+// matches the member or failover association given.
+func validateDHCPRangeData(d *schema.ResourceData) error {
+	for _, attr := range []string{"start_addr", "end_addr"} {
+		if ip := net.ParseIP(d.Get(attr).(string)); ip == nil || ip.To4() == nil {
+			return fmt.Errorf("'%s' must be an IPv4 address, got %q", attr, d.Get(attr).(string))
+		}
+	}
+
+	switch assoc := dhcpRangeServerAssociation(d); assoc {
+	case "NONE":
+	case "MEMBER":
+		if _, ok := d.GetOk("member"); !ok {
+			return fmt.Errorf("'member' must be set when server_association_type is MEMBER")
+		}
+	case "FAILOVER":
+		if _, ok := d.GetOk("failover_association"); !ok {
+			return fmt.Errorf("'failover_association' must be set when server_association_type is FAILOVER")
+		}
+	default:
+		return fmt.Errorf("server_association_type must be one of NONE, MEMBER or FAILOVER, got %q", assoc)
+	}
+	return nil
+}
+
+// Returns the server association type of the range, derived from the member
+// or failover association when it is not set explicitly. As the type is
+// computed, an unchanged value on update may just be the one read back from
+// the grid, which must follow a new member or failover association.
+func dhcpRangeServerAssociation(d *schema.ResourceData) string {
+	if attr, ok := d.GetOk("server_association_type"); ok && (d.Id() == "" || d.HasChange("server_association_type")) {
+		return attr.(string)
+	}
+	if _, ok := d.GetOk("member"); ok {
+		return "MEMBER"
+	}
+	if _, ok := d.GetOk("failover_association"); ok {
+		return "FAILOVER"
+	}
+	return "NONE"
+}
+
+func dhcpExclusionsFromList(exclusions []interface{}) []map[string]interface{} {
+	// WAPI rejects null lists, so always send at least an empty one.
+	result := make([]map[string]interface{}, 0, len(exclusions))
+
+	for _, v := range exclusions {
+		exclusionMap := v.(map[string]interface{})
+		result = append(result, map[string]interface{}{
+			"start_address": exclusionMap["start_address"].(string),
+			"end_address":   exclusionMap["end_address"].(string),
+			"comment":       exclusionMap["comment"].(string),
+		})
+	}
+	return result
+}
+
+func flattenDHCPExclusions(exclusions interface{}) []interface{} {
+	var result []interface{}
+
+	list, _ := exclusions.([]interface{})
+	for _, v := range list {
+		exclusion, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"start_address": exclusion["start_address"],
+			"end_address":   exclusion["end_address"],
+			"comment":       exclusion["comment"],
+		})
+	}
+	return result
+}
+
+// dhcpRangeObjectFromAttributes builds the body of a range create or update
+// request from the attributes as set by terraform.
+// The Infoblox WAPI does not allow the network_view of an existing range to be
+// changed, so we take an isUpdate arg to skip setting it.
+func dhcpRangeObjectFromAttributes(d *schema.ResourceData, meta interface{}, isUpdate bool) (map[string]interface{}, error) {
+	dhcpRange := make(map[string]interface{})
+
+	if !isUpdate {
+		dhcpRange["network_view"] = d.Get("network_view").(string)
+		if attr, ok := d.GetOk("network"); ok {
+			dhcpRange["network"] = attr.(string)
+		}
+	}
+
+	dhcpRange["start_addr"] = d.Get("start_addr").(string)
+	dhcpRange["end_addr"] = d.Get("end_addr").(string)
+	dhcpRange["name"] = d.Get("name").(string)
+	dhcpRange["comment"] = d.Get("comment").(string)
+
+	assoc := dhcpRangeServerAssociation(d)
+	dhcpRange["server_association_type"] = assoc
+	switch assoc {
+	case "MEMBER":
+		dhcpRange["member"] = dhcpMembersFromList(d.Get("member").([]interface{}))[0]
+	case "FAILOVER":
+		dhcpRange["failover_association"] = d.Get("failover_association").(string)
+	}
+
+	dhcpRange["exclude"] = dhcpExclusionsFromList(d.Get("exclusion").([]interface{}))
+
+	options := dhcpOptionsFromList(d.Get("option").([]interface{}))
+	dhcpRange["options"] = options
+	dhcpRange["use_options"] = len(options) > 0
+
+	extAttrs, err := extAttrsFromResourceData(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	extAttrs.addTo(dhcpRange)
+
+	return dhcpRange, nil
+}
+
+func resourceInfobloxDHCPRangeCreate(d *schema.ResourceData, meta interface{}) error {
+	if err := validateDHCPRangeData(d); err != nil {
+		return err
+	}
+
+	setDefaultNetworkView(d, meta)
+	client := meta.(*providerMeta).client
+
+	dhcpRange, err := dhcpRangeObjectFromAttributes(d, meta, false)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating Infoblox DHCP range with configuration: %#v", dhcpRange)
+
+	rangeID, err := wapiCreate(client, "range", dhcpRange)
+	if err != nil {
+		return fmt.Errorf("error creating Infoblox DHCP range: %s", err.Error())
+	}
+
+	d.SetId(rangeID)
+	log.Printf("[INFO] Infoblox DHCP range created with ID: %s", d.Id())
+
+	return resourceInfobloxDHCPRangeRead(d, meta)
+}
+
+func resourceInfobloxDHCPRangeRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	dhcpRange, err := wapiGet(client, d.Id(), dhcpRangeReturnFields)
+	if err != nil {
+		return handleReadError(d, "DHCP range", err)
+	}
+
+	d.Set("start_addr", dhcpRange["start_addr"])
+	d.Set("end_addr", dhcpRange["end_addr"])
+	d.Set("network", dhcpRange["network"])
+	d.Set("network_view", dhcpRange["network_view"])
+	d.Set("name", dhcpRange["name"])
+	d.Set("comment", dhcpRange["comment"])
+	d.Set("server_association_type", dhcpRange["server_association_type"])
+	d.Set("failover_association", dhcpRange["failover_association"])
+	d.Set("exclusion", flattenDHCPExclusions(dhcpRange["exclude"]))
+	d.Set("option", flattenDHCPOptions(dhcpRange["options"]))
+	d.Set("extensible_attributes", flattenExtAttrs(d, meta, dhcpRange["extattrs"]))
+
+	if member, ok := dhcpRange["member"]; ok && dhcpRange["server_association_type"] == "MEMBER" {
+		d.Set("member", flattenDHCPMembers([]interface{}{member}))
+	} else {
+		d.Set("member", nil)
+	}
+
+	return nil
+}
+
+func resourceInfobloxDHCPRangeUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := validateDHCPRangeData(d); err != nil {
+		return err
+	}
+
+	client := meta.(*providerMeta).client
+
+	dhcpRange, err := dhcpRangeObjectFromAttributes(d, meta, true)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Infoblox DHCP range with configuration: %#v", dhcpRange)
+
+	rangeID, err := wapiUpdate(client, d.Id(), dhcpRange)
+	if err != nil {
+		return fmt.Errorf("error updating Infoblox DHCP range: %s", err.Error())
+	}
+
+	d.SetId(rangeID)
+	log.Printf("[INFO] Infoblox DHCP range updated with ID: %s", d.Id())
+
+	return resourceInfobloxDHCPRangeRead(d, meta)
+}
+
+func resourceInfobloxDHCPRangeDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	log.Printf("[DEBUG] Deleting Infoblox DHCP range: %s-%s, %s",
+		d.Get("start_addr").(string), d.Get("end_addr").(string), d.Id())
+
+	err := wapiDelete(client, d.Id())
+	if err != nil && !isNotFoundError(err) {
+		return fmt.Errorf("error deleting Infoblox DHCP range: %s", err.Error())
+	}
+
+	return nil
+}
+
+// importInfobloxDHCPRange accepts either the WAPI object reference of a range
+// or a "<network_view>/<start_addr>-<end_addr>" ID such as
+// "default/10.0.0.100-10.0.0.199".
+func importInfobloxDHCPRange(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if strings.HasPrefix(d.Id(), "range/") {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	client := meta.(*providerMeta).client
+
+	parts := strings.SplitN(d.Id(), "/", 2)
+	var addrs []string
+	if len(parts) == 2 {
+		addrs = strings.Split(parts[1], "-")
+	}
+	if len(parts) != 2 || parts[0] == "" || len(addrs) != 2 || addrs[0] == "" || addrs[1] == "" {
+		return nil, fmt.Errorf(
+			"invalid import ID %q: must be a WAPI object reference or of the form <network_view>/<start_addr>-<end_addr>", d.Id())
+	}
+
+	ranges, err := wapiFind(client, "range", map[string]string{
+		"network_view": parts[0],
+		"start_addr":   addrs[0],
+		"end_addr":     addrs[1],
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error finding Infoblox DHCP range %s: %s", d.Id(), err)
+	}
+	if len(ranges) != 1 {
+		return nil, fmt.Errorf("expected one Infoblox DHCP range matching %s, found %d", d.Id(), len(ranges))
+	}
+
+	d.SetId(ranges[0]["_ref"].(string))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resolveIPRange returns the "<start>-<end>" form of an ip_range argument,
+// which is either already of that form or the WAPI object reference of a
+// DHCP range such as the ID of an infoblox_dhcp_range resource, or of an IPv6
+// range.
+func resolveIPRange(client *infoblox.Client, ipRange string) (string, error) {
+	if !strings.HasPrefix(ipRange, "range/") && !strings.HasPrefix(ipRange, "ipv6range/") {
+		return ipRange, nil
+	}
+
+	dhcpRange, err := wapiGet(client, ipRange, []string{"start_addr", "end_addr"})
+	if err != nil {
+		return "", fmt.Errorf("error finding Infoblox DHCP range %s: %s", ipRange, err)
+	}
+
+	start, _ := dhcpRange["start_addr"].(string)
+	end, _ := dhcpRange["end_addr"].(string)
+	return start + "-" + end, nil
+}
@@ -0,0 +1,257 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// addressACSchema represents the schema for an address access control entry,
+// as used to match the clients and destinations of a DNS view.
+func addressACSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"address": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateAddressMatch,
+		},
+		"permission": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "ALLOW",
+		},
+	}
+}
+
+func infobloxDNSView() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInfobloxDNSViewCreate,
+		Read:   resourceInfobloxDNSViewRead,
+		Update: resourceInfobloxDNSViewUpdate,
+		Delete: resourceInfobloxDNSViewDelete,
+		Importer: &schema.ResourceImporter{
+			State: importInfobloxDNSView,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateViewName,
+			},
+			"network_view": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"comment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"match_client": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Resource{Schema: addressACSchema()},
+			},
+			"match_destination": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Resource{Schema: addressACSchema()},
+			},
+			"recursion": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"extensible_attributes": extAttrsSchema(),
+		},
+	}
+}
+
+// dnsViewReturnFields lists the fields we read back for DNS views.
+var dnsViewReturnFields = []string{
+	"name", "network_view", "comment", "match_clients", "match_destinations", "recursion", "extattrs",
+}
+
+// Validates the permissions of the match_client and match_destination entries.
+func validateDNSViewData(d *schema.ResourceData) error {
+	for _, key := range []string{"match_client", "match_destination"} {
+		for _, v := range d.Get(key).([]interface{}) {
+			entry := v.(map[string]interface{})
+			if permission := entry["permission"].(string); permission != "ALLOW" && permission != "DENY" {
+				return fmt.Errorf("%s permission must be one of ALLOW or DENY, got %q", key, permission)
+			}
+		}
+	}
+	return nil
+}
+
+func addressACsFromList(entries []interface{}) []map[string]interface{} {
+	// WAPI rejects null lists, so always send at least an empty one.
+	result := make([]map[string]interface{}, 0, len(entries))
+
+	for _, v := range entries {
+		entry := v.(map[string]interface{})
+		result = append(result, map[string]interface{}{
+			"_struct":    "addressac",
+			"address":    entry["address"].(string),
+			"permission": entry["permission"].(string),
+		})
+	}
+	return result
+}
+
+func flattenAddressACs(entries interface{}) []interface{} {
+	var result []interface{}
+
+	list, _ := entries.([]interface{})
+	for _, v := range list {
+		entry, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		// TSIG key based entries have no address and are not managed here.
+		if _, ok := entry["address"]; !ok {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"address":    entry["address"],
+			"permission": entry["permission"],
+		})
+	}
+	return result
+}
+
+// dnsViewObjectFromAttributes builds the body of a view create or update
+// request from the attributes as set by terraform.
+// The Infoblox WAPI does not allow the network_view of an existing view to be
+// changed, so we take an isUpdate arg to skip setting it.
+func dnsViewObjectFromAttributes(d *schema.ResourceData, meta interface{}, isUpdate bool) (map[string]interface{}, error) {
+	view := make(map[string]interface{})
+
+	if !isUpdate {
+		view["network_view"] = d.Get("network_view").(string)
+	}
+
+	view["name"] = d.Get("name").(string)
+	view["comment"] = d.Get("comment").(string)
+	view["match_clients"] = addressACsFromList(d.Get("match_client").([]interface{}))
+	view["match_destinations"] = addressACsFromList(d.Get("match_destination").([]interface{}))
+	view["recursion"] = d.Get("recursion").(bool)
+
+	extAttrs, err := extAttrsFromResourceData(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	extAttrs.addTo(view)
+
+	return view, nil
+}
+
+func resourceInfobloxDNSViewCreate(d *schema.ResourceData, meta interface{}) error {
+	if err := validateDNSViewData(d); err != nil {
+		return err
+	}
+
+	setDefaultNetworkView(d, meta)
+	client := meta.(*providerMeta).client
+
+	view, err := dnsViewObjectFromAttributes(d, meta, false)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating Infoblox DNS view with configuration: %#v", view)
+
+	viewID, err := wapiCreate(client, "view", view)
+	if err != nil {
+		return fmt.Errorf("error creating Infoblox DNS view: %s", err.Error())
+	}
+
+	d.SetId(viewID)
+	log.Printf("[INFO] Infoblox DNS view created with ID: %s", d.Id())
+
+	return resourceInfobloxDNSViewRead(d, meta)
+}
+
+func resourceInfobloxDNSViewRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	view, err := wapiGet(client, d.Id(), dnsViewReturnFields)
+	if err != nil {
+		return handleReadError(d, "DNS view", err)
+	}
+
+	d.Set("name", view["name"])
+	d.Set("network_view", view["network_view"])
+	d.Set("comment", view["comment"])
+	d.Set("match_client", flattenAddressACs(view["match_clients"]))
+	d.Set("match_destination", flattenAddressACs(view["match_destinations"]))
+	d.Set("recursion", view["recursion"])
+	d.Set("extensible_attributes", flattenExtAttrs(d, meta, view["extattrs"]))
+
+	return nil
+}
+
+func resourceInfobloxDNSViewUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := validateDNSViewData(d); err != nil {
+		return err
+	}
+
+	client := meta.(*providerMeta).client
+
+	view, err := dnsViewObjectFromAttributes(d, meta, true)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Infoblox DNS view with configuration: %#v", view)
+
+	viewID, err := wapiUpdate(client, d.Id(), view)
+	if err != nil {
+		return fmt.Errorf("error updating Infoblox DNS view: %s", err.Error())
+	}
+
+	d.SetId(viewID)
+	log.Printf("[INFO] Infoblox DNS view updated with ID: %s", d.Id())
+
+	return resourceInfobloxDNSViewRead(d, meta)
+}
+
+func resourceInfobloxDNSViewDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	log.Printf("[DEBUG] Deleting Infoblox DNS view: %s, %s", d.Get("name").(string), d.Id())
+
+	err := wapiDelete(client, d.Id())
+	if err != nil && !isNotFoundError(err) {
+		return fmt.Errorf("error deleting Infoblox DNS view: %s", err.Error())
+	}
+
+	return nil
+}
+
+// importInfobloxDNSView accepts either the WAPI object reference of a DNS view
+// or its name, which is unique across the grid.
+func importInfobloxDNSView(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if strings.HasPrefix(d.Id(), "view/") {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	client := meta.(*providerMeta).client
+
+	views, err := wapiFind(client, "view", map[string]string{"name": d.Id()}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error finding Infoblox DNS view %s: %s", d.Id(), err)
+	}
+	if len(views) != 1 {
+		return nil, fmt.Errorf("expected one Infoblox DNS view matching %s, found %d", d.Id(), len(views))
+	}
+
+	d.SetId(views[0]["_ref"].(string))
+
+	return []*schema.ResourceData{d}, nil
+}
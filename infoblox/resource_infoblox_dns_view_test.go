@@ -0,0 +1,27 @@
+package infoblox
+
+import (
+	"testing"
+)
+
+func TestMockInfobloxDNSViewImport(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	ref := m.add("view", map[string]interface{}{
+		"name":         "internal",
+		"network_view": "default",
+	})
+
+	r := infobloxDNSView()
+	d := r.TestResourceData()
+	d.SetId("internal")
+
+	imported, err := r.Importer.State(d, m.meta())
+	if err != nil {
+		t.Fatalf("error importing DNS view: %s", err)
+	}
+	if imported[0].Id() != ref {
+		t.Fatalf("expected import to resolve to %s, got %s", ref, imported[0].Id())
+	}
+}
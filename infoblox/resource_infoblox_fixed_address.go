@@ -0,0 +1,276 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func infobloxFixedAddress() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInfobloxFixedAddressCreate,
+		Read:   resourceInfobloxFixedAddressRead,
+		Update: resourceInfobloxFixedAddressUpdate,
+		Delete: resourceInfobloxFixedAddressDelete,
+		Importer: &schema.ResourceImporter{
+			State: importInfobloxFixedAddress,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ipv4addr": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"network"},
+				ValidateFunc:  validateIPv4Address,
+			},
+			"network": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"ipv4addr"},
+				ValidateFunc:  validateCIDR,
+			},
+			"network_view": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"match_client": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "MAC_ADDRESS",
+			},
+			"mac": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateMACAddress,
+			},
+			"client_identifier": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"comment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"option": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Resource{Schema: dhcpOptionSchema()},
+			},
+			"extensible_attributes": extAttrsSchema(),
+		},
+	}
+}
+
+// fixedAddressReturnFields lists the fields we read back for fixed addresses.
+var fixedAddressReturnFields = []string{
+	"ipv4addr", "network", "network_view", "match_client", "mac", "dhcp_client_identifier",
+	"name", "comment", "options", "extattrs",
+}
+
+// Validates that the address is given either explicitly or as a network to
+// allocate from, and that the client is identified the way match_client says.
+func validateFixedAddressData(d *schema.ResourceData) error {
+	_, ipOk := d.GetOk("ipv4addr")
+	_, networkOk := d.GetOk("network")
+	if !ipOk && !networkOk {
+		return fmt.Errorf(
+			"One of ['ipv4addr', 'network'] must be set to create an Infoblox fixed address")
+	}
+
+	switch matchClient := d.Get("match_client").(string); matchClient {
+	case "MAC_ADDRESS":
+		if _, ok := d.GetOk("mac"); !ok {
+			return fmt.Errorf("'mac' must be set when match_client is MAC_ADDRESS")
+		}
+	case "CLIENT_ID":
+		if _, ok := d.GetOk("client_identifier"); !ok {
+			return fmt.Errorf("'client_identifier' must be set when match_client is CLIENT_ID")
+		}
+	case "RESERVED", "CIRCUIT_ID", "REMOTE_ID":
+	default:
+		return fmt.Errorf(
+			"match_client must be one of MAC_ADDRESS, CLIENT_ID, RESERVED, CIRCUIT_ID or REMOTE_ID, got %q", matchClient)
+	}
+	return nil
+}
+
+// fixedAddressObjectFromAttributes builds the body of a fixedaddress create or
+// update request from the attributes as set by terraform.
+// The Infoblox WAPI does not allow the network_view of an existing fixed
+// address to be changed, so we take an isUpdate arg to skip setting it.
+func fixedAddressObjectFromAttributes(d *schema.ResourceData, meta interface{}, isUpdate bool) (map[string]interface{}, error) {
+	fixedAddress := make(map[string]interface{})
+
+	if !isUpdate {
+		if attr, ok := d.GetOk("ipv4addr"); ok {
+			fixedAddress["ipv4addr"] = attr.(string)
+		} else {
+			// Let the grid allocate the next available address of the
+			// network in the same request that reserves it.
+			fixedAddress["ipv4addr"] = fmt.Sprintf("func:nextavailableip:%s,%s",
+				d.Get("network").(string), d.Get("network_view").(string))
+		}
+		fixedAddress["network_view"] = d.Get("network_view").(string)
+	} else if _, ok := d.GetOk("network"); !ok && d.HasChange("ipv4addr") {
+		fixedAddress["ipv4addr"] = d.Get("ipv4addr").(string)
+	}
+
+	matchClient := d.Get("match_client").(string)
+	fixedAddress["match_client"] = matchClient
+	switch matchClient {
+	case "MAC_ADDRESS":
+		fixedAddress["mac"] = d.Get("mac").(string)
+	case "CLIENT_ID":
+		fixedAddress["dhcp_client_identifier"] = d.Get("client_identifier").(string)
+	case "RESERVED":
+		fixedAddress["mac"] = reservedMAC
+	}
+
+	fixedAddress["name"] = d.Get("name").(string)
+	fixedAddress["comment"] = d.Get("comment").(string)
+
+	options := dhcpOptionsFromList(d.Get("option").([]interface{}))
+	fixedAddress["options"] = options
+	fixedAddress["use_options"] = len(options) > 0
+
+	extAttrs, err := extAttrsFromResourceData(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	extAttrs.addTo(fixedAddress)
+
+	return fixedAddress, nil
+}
+
+func resourceInfobloxFixedAddressCreate(d *schema.ResourceData, meta interface{}) error {
+	if err := validateFixedAddressData(d); err != nil {
+		return err
+	}
+
+	setDefaultNetworkView(d, meta)
+	client := meta.(*providerMeta).client
+
+	fixedAddress, err := fixedAddressObjectFromAttributes(d, meta, false)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating Infoblox fixed address with configuration: %#v", fixedAddress)
+
+	fixedAddressID, err := wapiCreate(client, "fixedaddress", fixedAddress)
+	if err != nil {
+		return fmt.Errorf("error creating Infoblox fixed address: %s", err.Error())
+	}
+
+	d.SetId(fixedAddressID)
+	log.Printf("[INFO] Infoblox fixed address created with ID: %s", d.Id())
+
+	return resourceInfobloxFixedAddressRead(d, meta)
+}
+
+func resourceInfobloxFixedAddressRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	fixedAddress, err := wapiGet(client, d.Id(), fixedAddressReturnFields)
+	if err != nil {
+		return handleReadError(d, "fixed address", err)
+	}
+
+	d.Set("ipv4addr", fixedAddress["ipv4addr"])
+	d.Set("network_view", fixedAddress["network_view"])
+	d.Set("match_client", fixedAddress["match_client"])
+	d.Set("name", fixedAddress["name"])
+	d.Set("comment", fixedAddress["comment"])
+	d.Set("option", flattenDHCPOptions(fixedAddress["options"]))
+	d.Set("extensible_attributes", flattenExtAttrs(d, meta, fixedAddress["extattrs"]))
+
+	// WAPI keeps a placeholder MAC address on fixed addresses which are not
+	// matched by MAC, so only track it when it identifies the client.
+	if fixedAddress["match_client"] == "MAC_ADDRESS" {
+		d.Set("mac", fixedAddress["mac"])
+	}
+	if id, ok := fixedAddress["dhcp_client_identifier"]; ok {
+		d.Set("client_identifier", id)
+	}
+
+	return nil
+}
+
+func resourceInfobloxFixedAddressUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := validateFixedAddressData(d); err != nil {
+		return err
+	}
+
+	client := meta.(*providerMeta).client
+
+	fixedAddress, err := fixedAddressObjectFromAttributes(d, meta, true)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Infoblox fixed address with configuration: %#v", fixedAddress)
+
+	fixedAddressID, err := wapiUpdate(client, d.Id(), fixedAddress)
+	if err != nil {
+		return fmt.Errorf("error updating Infoblox fixed address: %s", err.Error())
+	}
+
+	d.SetId(fixedAddressID)
+	log.Printf("[INFO] Infoblox fixed address updated with ID: %s", d.Id())
+
+	return resourceInfobloxFixedAddressRead(d, meta)
+}
+
+func resourceInfobloxFixedAddressDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	log.Printf("[DEBUG] Deleting Infoblox fixed address: %s, %s", d.Get("ipv4addr").(string), d.Id())
+
+	err := wapiDelete(client, d.Id())
+	if err != nil && !isNotFoundError(err) {
+		return fmt.Errorf("error deleting Infoblox fixed address: %s", err.Error())
+	}
+
+	return nil
+}
+
+// importInfobloxFixedAddress accepts either the WAPI object reference of a
+// fixed address or a "<network_view>/<ipv4addr>" ID such as "default/10.0.0.10".
+func importInfobloxFixedAddress(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if strings.HasPrefix(d.Id(), "fixedaddress/") {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	client := meta.(*providerMeta).client
+
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf(
+			"invalid import ID %q: must be a WAPI object reference or of the form <network_view>/<ipv4addr>", d.Id())
+	}
+
+	fixedAddresses, err := wapiFind(client, "fixedaddress", map[string]string{
+		"network_view": parts[0],
+		"ipv4addr":     parts[1],
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error finding Infoblox fixed address %s: %s", d.Id(), err)
+	}
+	if len(fixedAddresses) != 1 {
+		return nil, fmt.Errorf("expected one Infoblox fixed address matching %s, found %d", d.Id(), len(fixedAddresses))
+	}
+
+	d.SetId(fixedAddresses[0]["_ref"].(string))
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,84 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestMockInfobloxFixedAddress(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	m.add("network", map[string]interface{}{
+		"network":      "10.0.0.0/24",
+		"network_view": "default",
+	})
+	m.add("fixedaddress", map[string]interface{}{
+		"ipv4addr":     "10.0.0.1",
+		"network_view": "default",
+	})
+
+	testMockLifecycle(t, m, mockLifecycleTest{
+		Resource:   infobloxFixedAddress(),
+		ObjectType: "fixedaddress",
+		Create: map[string]interface{}{
+			"network": "10.0.0.0/24",
+			"mac":     "00:50:56:00:00:01",
+			"name":    "printer",
+		},
+		CreateCheck: map[string]string{
+			"ipv4addr":     "10.0.0.2",
+			"mac":          "00:50:56:00:00:01",
+			"match_client": "MAC_ADDRESS",
+			"network_view": "default",
+		},
+		Update: map[string]interface{}{
+			"network":           "10.0.0.0/24",
+			"match_client":      "CLIENT_ID",
+			"client_identifier": "01:00:50:56:00:00:01",
+			"name":              "printer",
+			"option": []interface{}{
+				map[string]interface{}{"name": "tftp-server-name", "value": "tftp.example.com"},
+			},
+		},
+		UpdateCheck: map[string]string{
+			"ipv4addr":          "10.0.0.2",
+			"match_client":      "CLIENT_ID",
+			"client_identifier": "01:00:50:56:00:00:01",
+			"option.#":          "1",
+		},
+	})
+
+	r := infobloxFixedAddress()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"ipv4addr": "10.0.0.20",
+	})
+	if err := r.Create(d, m.meta()); err == nil {
+		t.Fatalf("expected an error creating a fixed address matched by MAC without a mac")
+	}
+}
+
+func TestMockInfobloxFixedAddressImport(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	ref := m.add("fixedaddress", map[string]interface{}{
+		"ipv4addr":     "10.0.0.10",
+		"network_view": "default",
+		"mac":          "00:50:56:00:00:01",
+		"match_client": "MAC_ADDRESS",
+	})
+
+	r := infobloxFixedAddress()
+	d := r.TestResourceData()
+	d.SetId("default/10.0.0.10")
+
+	imported, err := r.Importer.State(d, m.meta())
+	if err != nil {
+		t.Fatalf("error importing fixed address: %s", err)
+	}
+	if imported[0].Id() != ref {
+		t.Fatalf("expected import to resolve to %s, got %s", ref, imported[0].Id())
+	}
+}
@@ -9,6 +9,18 @@ package infoblox
    then invoke NextAvailableIP against it, and return the result in a variable called
    "ipaddress".
 
+   Addresses are allocated from IPv6 networks and ranges just the same; the
+   address family is detected from the CIDR or range.
+
+   Several addresses can be allocated at once with "num_addresses", in which
+   case they are all returned in "ipaddresses", optionally as one contiguous
+   block of addresses.
+
+   The address is reserved on the grid so that no one else can be handed it:
+   by default as a DHCP reservation, or, depending on "reserve_as", as a fixed
+   address or a host record. The reservation is released when the resource is
+   destroyed.
+
    Note: this entire resource should probably be deprecated if someone
    implements a full Network resource (though the complexity of the
    API for such a resource might make it advisable to leave this
@@ -47,12 +59,17 @@ resource "infoblox_record" "foobar" {
 
 import (
 	"fmt"
+	"log"
+	"net"
 	"strings"
 
 	"github.com/fanatic/go-infoblox"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
+// The MAC address WAPI uses for fixed addresses which are only reserved.
+const reservedMAC = "00:00:00:00:00:00"
+
 func resourceInfobloxIP() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceInfobloxIPCreate,
@@ -60,18 +77,23 @@ func resourceInfobloxIP() *schema.Resource {
 		Update: resourceInfobloxIPUpdate,
 		Delete: resourceInfobloxIPDelete,
 
+		SchemaVersion: 1,
+		MigrateState:  resourceInfobloxIPMigrateState,
+
 		Schema: map[string]*schema.Schema{
 			"cidr": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: false,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCIDR,
 			},
 
 			"ip_range": &schema.Schema{
 				Type:          schema.TypeString,
 				Optional:      true,
-				ForceNew:      false,
+				ForceNew:      true,
 				ConflictsWith: []string{"cidr"},
+				ValidateFunc:  validateIPRange,
 			},
 
 			"ipaddress": &schema.Schema{
@@ -80,114 +102,717 @@ func resourceInfobloxIP() *schema.Resource {
 				Required: false,
 			},
 
+			"num_addresses": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  1,
+			},
+
+			"contiguous": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"ipaddresses": &schema.Schema{
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+
 			"exclude": &schema.Schema{
 				Type:     schema.TypeSet,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: validateIPAddress},
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"atomic_allocation": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"reserve_as": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "reservation",
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"mac": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateMACAddress,
+			},
+
+			"duid": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDUID,
+			},
+
+			"network_view": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"comment": &schema.Schema{
+				Type:     schema.TypeString,
 				Optional: true,
 			},
+
+			"extensible_attributes": extAttrsSchema(),
 		},
 	}
 }
 
+// Validates the combination of arguments used to reserve an address of the
+// given family, "ipv4addr" or "ipv6addr".
+func validateIPReservation(d *schema.ResourceData, family string) error {
+	switch d.Get("reserve_as").(string) {
+	case "reservation":
+		if family == "ipv6addr" {
+			return fmt.Errorf(
+				"IPv6 addresses cannot be reserved without a client, set 'reserve_as' to 'host' or to 'fixed_address' with a 'duid'")
+		}
+		if _, ok := d.GetOk("mac"); ok {
+			return fmt.Errorf("a reservation is not bound to a client, 'mac' can only be set when 'reserve_as' is 'fixed_address'")
+		}
+	case "fixed_address":
+		if family == "ipv6addr" {
+			if _, ok := d.GetOk("duid"); !ok {
+				return fmt.Errorf("'duid' must be set to reserve an Infoblox IPv6 address as a fixed address")
+			}
+		} else if _, ok := d.GetOk("mac"); !ok {
+			return fmt.Errorf("'mac' must be set to reserve an Infoblox IP as a fixed address")
+		}
+	case "host":
+		if _, ok := d.GetOk("name"); !ok {
+			return fmt.Errorf("'name' must be set to reserve an Infoblox IP as a host record")
+		}
+		if _, ok := d.GetOk("mac"); ok {
+			return fmt.Errorf("'mac' can only be set when 'reserve_as' is 'fixed_address'")
+		}
+	default:
+		return fmt.Errorf(
+			"'reserve_as' must be one of ['reservation', 'fixed_address', 'host'], got %q", d.Get("reserve_as").(string))
+	}
+
+	if num := d.Get("num_addresses").(int); num < 1 {
+		return fmt.Errorf("'num_addresses' must be at least 1, got %d", num)
+	} else if num > 1 {
+		if d.Get("reserve_as").(string) == "fixed_address" {
+			return fmt.Errorf("a fixed address binds a single address to its client, 'num_addresses' must be 1")
+		}
+		if d.Get("atomic_allocation").(bool) {
+			return fmt.Errorf("'atomic_allocation' can only allocate a single address, 'num_addresses' must be 1")
+		}
+	}
+	return nil
+}
+
+// Returns the family of the addresses the resource allocates, "ipv4addr" or
+// "ipv6addr" as returned by ipType, judging by the address of its CIDR or the
+// start of its range. CIDRs which are not of the address/prefix form, such as
+// other search terms of the network, are taken to be IPv4.
+func ipAllocationFamily(d *schema.ResourceData, ipRange string) string {
+	address := strings.Split(d.Get("cidr").(string), "/")[0]
+	if ipRange != "" {
+		address = strings.Split(ipRange, "-")[0]
+	}
+
+	family, err := ipType(address)
+	if err != nil {
+		return "ipv4addr"
+	}
+	return family
+}
+
+// Resources created before infoblox_ip reserved its addresses have the address
+// itself as their ID rather than a WAPI object reference, and there is nothing
+// on the grid for us to read, update or release. They have to be recreated to
+// reserve their address.
+func isUnreservedIP(d *schema.ResourceData) bool {
+	return !strings.Contains(d.Id(), "/")
+}
+
+// Returns the WAPI object references of the reservations of the resource.
+// Several addresses reserved as host are held by a single host record, but
+// reservations are one fixed address per address, so the ID of a resource
+// holding several of them lists all their references separated by commas.
+func ipReservationRefs(d *schema.ResourceData) []string {
+	return strings.Split(d.Id(), ",")
+}
+
 func resourceInfobloxIPCreate(d *schema.ResourceData, meta interface{}) error {
 	if err := validateIPData(d); err != nil {
 		return err
 	}
 
-	var (
-		result string
-		err    error
-	)
+	var addresses []interface{}
 
-	client := meta.(*infoblox.Client)
+	setDefaultNetworkView(d, meta)
+	client := meta.(*providerMeta).client
 	excludedAddresses := buildExcludedAddressesArray(d)
 
-	if cidr, ok := d.GetOk("cidr"); ok {
-		result, err = getNextAvailableIPFromCIDR(client, cidr.(string), excludedAddresses)
-	} else if ipRange, ok := d.GetOk("ip_range"); ok {
-		result, err = getNextAvailableIPFromRange(client, ipRange.(string))
+	ipRange, err := resolveIPRange(client, d.Get("ip_range").(string))
+	if err != nil {
+		return err
+	}
+
+	family := ipAllocationFamily(d, ipRange)
+	if err := validateIPReservation(d, family); err != nil {
+		return err
+	}
+
+	num := d.Get("num_addresses").(int)
+	if num == 1 && (d.Get("atomic_allocation").(bool) || (d.Get("reserve_as").(string) == "host" && len(excludedAddresses) == 0)) {
+		// Let the grid allocate the address as part of the same request
+		// which reserves it, leaving no window for anyone else to be
+		// handed the address in between.
+		addresses = append(addresses, nextAvailableIPFunc(d, family, ipRange, excludedAddresses))
+	} else {
+		// Looking up the next available address and reserving it are two
+		// requests, so serialize allocations from the same network;
+		// otherwise parallel resources get handed the same address.
+		network, err := ipAllocationNetwork(client, d, family, ipRange)
+		if err != nil {
+			return err
+		}
+		ipAllocationLocks.Lock(network)
+		defer ipAllocationLocks.Unlock(network)
+
+		var ips []string
+		if d.Get("contiguous").(bool) {
+			ips, err = getNextAvailableContiguousIPs(client, d, family, ipRange, network, excludedAddresses, num)
+		} else {
+			ips, err = getNextAvailableIPs(client, d, family, ipRange, network, excludedAddresses, num)
+		}
+		if err != nil {
+			return err
+		}
+		for _, ip := range ips {
+			addresses = append(addresses, ip)
+		}
+	}
+
+	extAttrs, err := extAttrsFromResourceData(d, meta)
+	if err != nil {
+		return err
 	}
 
+	refs, err := reserveIPs(client, d, family, addresses, extAttrs)
 	if err != nil {
 		return err
 	}
 
-	d.SetId(result)
-	d.Set("ipaddress", result)
+	d.SetId(strings.Join(refs, ","))
+	log.Printf("[INFO] Infoblox IP reserved with ID: %s", d.Id())
 
-	return nil
+	return resourceInfobloxIPRead(d, meta)
 }
 
-func getNextAvailableIPFromCIDR(client *infoblox.Client, cidr string, excludedAddresses []string) (string, error) {
-	var (
-		result string
-		err    error
-		ou     map[string]interface{}
-	)
-
-	network, err := getNetworks(client, cidr)
+// ipAllocationLocks serializes next available IP lookups and the reservations
+// which follow them, keyed by the reference of the network allocated from.
+var ipAllocationLocks = newMutexKV()
+
+// Returns the WAPI object reference of the network the addresses of the
+// resource are allocated from: the network of its cidr, or the network
+// containing its range. Allocations from a range hand out addresses of its
+// network as well, so they lock on the network too.
+func ipAllocationNetwork(client *infoblox.Client, d *schema.ResourceData, family, ipRange string) (string, error) {
+	if ipRange != "" {
+		start := strings.Split(ipRange, "-")[0]
+		objectType := ipNetworkObjects[family]
+
+		networks, err := wapiFind(client, objectType, map[string]string{
+			"contains_address": start,
+			"network_view":     d.Get("network_view").(string),
+		}, nil)
+		if err != nil {
+			return "", fmt.Errorf("error finding Infoblox %s of range %s: %s", objectType, ipRange, err)
+		}
+		if len(networks) == 0 {
+			return "", fmt.Errorf("[ERROR] Empty response from %s search. Is %s inside a valid %s?", objectType, ipRange, objectType)
+		}
+		return networks[0]["_ref"].(string), nil
+	}
+	if family == "ipv6addr" {
+		return findIPv6AllocationObject(client, d, ipRange)
+	}
 
+	// The same CIDR may exist in several network views, so the network is
+	// looked up in the one of the resource.
+	cidr := d.Get("cidr").(string)
+	networks, err := wapiFind(client, "network", map[string]string{
+		"network":      cidr,
+		"network_view": d.Get("network_view").(string),
+	}, nil)
 	if err != nil {
 		if strings.Contains(err.Error(), "Authorization Required") {
 			return "", fmt.Errorf("[ERROR] Authentication Error, Please check your username/password ")
 		}
+		return "", fmt.Errorf("error finding Infoblox network %s: %s", cidr, err)
+	}
+	if len(networks) == 0 {
+		return "", fmt.Errorf("[ERROR] Empty response from network search. Is %s a valid network in network view %s?", cidr, d.Get("network_view").(string))
+	}
+	return networks[0]["_ref"].(string), nil
+}
+
+// Returns the WAPI function which allocates the next available address from
+// the network or range of the resource when used as an address. The short
+// func:nextavailableip form cannot exclude addresses, so the full object
+// function form is used when there are any to exclude.
+func nextAvailableIPFunc(d *schema.ResourceData, family, ipRange string, excludedAddresses []string) interface{} {
+	networkView := d.Get("network_view").(string)
+
+	if ipRange != "" {
+		return fmt.Sprintf("func:nextavailableip:%s,%s", ipRange, networkView)
+	}
+	if len(excludedAddresses) == 0 {
+		return fmt.Sprintf("func:nextavailableip:%s,%s", d.Get("cidr").(string), networkView)
 	}
 
-	if len(network) == 0 {
-		err = fmt.Errorf("[ERROR] Empty response from client.Network().find. Is %s a valid network?", cidr)
+	return map[string]interface{}{
+		"_object_function": "next_available_ip",
+		"_object":          ipNetworkObjects[family],
+		"_object_parameters": map[string]interface{}{
+			"network":      d.Get("cidr").(string),
+			"network_view": networkView,
+		},
+		"_parameters": map[string]interface{}{
+			"exclude": excludedAddresses,
+		},
+		"_result_field": "ips",
 	}
+}
+
+// ipNetworkObjects maps the address families returned by ipType to the WAPI
+// object type of their networks.
+var ipNetworkObjects = map[string]string{
+	"ipv4addr": "network",
+	"ipv6addr": "ipv6network",
+}
 
-	if err == nil {
-		ou, err = client.NetworkObject(network[0]["_ref"].(string)).NextAvailableIP(1, excludedAddresses)
-		result = getMapValueAsString(ou, "ips")
-		if result == "" {
-			err = fmt.Errorf("[ERROR] Unable to determine IP address from response")
+// Reserves the given addresses of the given family as configured by the
+// "reserve_as" argument and returns the WAPI object references of the
+// reservations. If any of the addresses cannot be reserved, those reserved
+// so far are released again.
+func reserveIPs(client *infoblox.Client, d *schema.ResourceData, family string, addresses []interface{}, extAttrs extAttrFields) ([]string, error) {
+	if d.Get("reserve_as").(string) == "host" {
+		addrs := make([]map[string]interface{}, 0, len(addresses))
+		for _, address := range addresses {
+			addrs = append(addrs, map[string]interface{}{family: address})
 		}
+
+		host := map[string]interface{}{
+			"name":              d.Get("name").(string),
+			"configure_for_dns": false,
+			"comment":           d.Get("comment").(string),
+			family + "s":        addrs,
+		}
+		extAttrs.addTo(host)
+		ref, err := wapiCreate(client, "record:host", host)
+		if err != nil {
+			return nil, fmt.Errorf("error reserving Infoblox IP %v: %s", addresses, err.Error())
+		}
+		return []string{ref}, nil
 	}
 
-	return result, err
+	var refs []string
+	for _, address := range addresses {
+		ref, err := reserveFixedIP(client, d, family, address, extAttrs)
+		if err != nil {
+			for _, r := range refs {
+				if err := wapiDelete(client, r); err != nil {
+					log.Printf("[WARN] Unable to release Infoblox IP reservation %s: %s", r, err)
+				}
+			}
+			return nil, fmt.Errorf("error reserving Infoblox IP %v: %s", address, err.Error())
+		}
+		refs = append(refs, ref)
+	}
+	return refs, nil
 }
 
-func getNextAvailableIPFromRange(client *infoblox.Client, ipRange string) (string, error) {
-	var (
-		result string
-		err    error
-	)
+// Reserves a single address as a fixed address and returns its WAPI object
+// reference.
+func reserveFixedIP(client *infoblox.Client, d *schema.ResourceData, family string, address interface{}, extAttrs extAttrFields) (string, error) {
+	if family == "ipv6addr" {
+		// validateIPReservation only lets IPv6 addresses through as
+		// fixed addresses matched by DUID.
+		fixedAddress := map[string]interface{}{
+			"ipv6addr":     address,
+			"duid":         d.Get("duid").(string),
+			"network_view": d.Get("network_view").(string),
+			"name":         d.Get("name").(string),
+			"comment":      d.Get("comment").(string),
+		}
+		extAttrs.addTo(fixedAddress)
+		return wapiCreate(client, "ipv6fixedaddress", fixedAddress)
+	}
+
+	fixedAddress := map[string]interface{}{
+		"ipv4addr":     address,
+		"network_view": d.Get("network_view").(string),
+		"name":         d.Get("name").(string),
+		"comment":      d.Get("comment").(string),
+	}
+	extAttrs.addTo(fixedAddress)
+	if d.Get("reserve_as").(string) == "fixed_address" {
+		fixedAddress["match_client"] = "MAC_ADDRESS"
+		fixedAddress["mac"] = d.Get("mac").(string)
+	} else {
+		fixedAddress["match_client"] = "RESERVED"
+		fixedAddress["mac"] = reservedMAC
+	}
+	return wapiCreate(client, "fixedaddress", fixedAddress)
+}
+
+// Returns the next num available addresses of the network or range of the
+// resource, skipping the excluded addresses. network is the reference
+// returned by ipAllocationNetwork.
+func getNextAvailableIPs(client *infoblox.Client, d *schema.ResourceData, family, ipRange, network string, excludedAddresses []string, num int) ([]string, error) {
+	if family == "ipv6addr" {
+		ref := network
+		if ipRange != "" {
+			var err error
+			ref, err = findIPv6AllocationObject(client, d, ipRange)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return getNextAvailableIPv6(client, ref, excludedAddresses, num)
+	}
+	if ipRange != "" {
+		return getNextAvailableIPFromRange(client, ipRange, excludedAddresses, num)
+	}
+	return getNextAvailableIPFromNetwork(client, network, excludedAddresses, num)
+}
+
+// maxContiguousIPAttempts bounds how many times we look further into a
+// network or range for a contiguous block of available addresses.
+const maxContiguousIPAttempts = 10
+
+// Returns num contiguous available addresses of the network or range of the
+// resource. WAPI cannot be asked for contiguous addresses, so we ask for the
+// next num available ones instead. If they have a gap, no contiguous block can
+// start at or before the last gap, so we exclude those addresses and ask again.
+func getNextAvailableContiguousIPs(client *infoblox.Client, d *schema.ResourceData, family, ipRange, network string, excludedAddresses []string, num int) ([]string, error) {
+	exclude := append([]string(nil), excludedAddresses...)
+
+	for attempt := 0; attempt < maxContiguousIPAttempts; attempt++ {
+		ips, err := getNextAvailableIPs(client, d, family, ipRange, network, exclude, num)
+		if err != nil {
+			return nil, err
+		}
+
+		gap := lastIPGap(ips)
+		if gap < 0 {
+			return ips, nil
+		}
+		exclude = append(exclude, ips[:gap+1]...)
+	}
+
+	return nil, fmt.Errorf("[ERROR] Unable to find %d contiguous available IP addresses after %d attempts", num, maxContiguousIPAttempts)
+}
+
+// Returns the index of the last of the given ascending addresses which is not
+// directly followed by the next address, or -1 if they are contiguous.
+func lastIPGap(ips []string) int {
+	for i := len(ips) - 2; i >= 0; i-- {
+		ip, next := net.ParseIP(ips[i]), net.ParseIP(ips[i+1])
+		if ip == nil || next == nil || !nextIP(ip).Equal(next) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Returns the address following ip.
+func nextIP(ip net.IP) net.IP {
+	next := make(net.IP, len(ip))
+	copy(next, ip)
+	for i := len(next) - 1; i >= 0; i-- {
+		next[i]++
+		if next[i] != 0 {
+			break
+		}
+	}
+	return next
+}
+
+// Returns the next num available addresses of the network with the given
+// WAPI object reference.
+func getNextAvailableIPFromNetwork(client *infoblox.Client, network string, excludedAddresses []string, num int) ([]string, error) {
+	ou, err := client.NetworkObject(network).NextAvailableIP(num, excludedAddresses)
+	if err != nil {
+		return nil, err
+	}
 
+	result := getMapValueAsStrings(ou, "ips")
+	if len(result) == 0 {
+		return nil, fmt.Errorf("[ERROR] Unable to determine IP address from response")
+	}
+	return result, nil
+}
+
+func getNextAvailableIPFromRange(client *infoblox.Client, ipRange string, excludedAddresses []string, num int) ([]string, error) {
 	ips := strings.Split(ipRange, "-")
 	if len(ips) != 2 {
-		return "", fmt.Errorf("[ERROR] ip_range must be of format <ipv4 addresss>-<ipv4 address>. Instead found: %s", ipRange)
+		return nil, fmt.Errorf("[ERROR] ip_range must be of format <start address>-<end address>. Instead found: %s", ipRange)
 	}
 
 	ou, err := client.FindUnusedIPInRange(ips[0], ips[1])
-	result = ou[0].IPAddress
+	if err != nil {
+		return nil, err
+	}
+
+	excluded := make(map[string]bool)
+	for _, ip := range excludedAddresses {
+		excluded[ip] = true
+	}
+
+	var result []string
+	for _, unused := range ou {
+		if len(result) == num {
+			break
+		}
+		if !excluded[unused.IPAddress] {
+			result = append(result, unused.IPAddress)
+		}
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("[ERROR] No unused IP address found in range %s", ipRange)
+	}
+	if len(result) < num {
+		return nil, fmt.Errorf("[ERROR] Only %d of %d unused IP addresses found in range %s", len(result), num, ipRange)
+	}
 
-	return result, err
+	return result, nil
+}
+
+// Finds the ipv6network matching the CIDR of the resource, or the ipv6range
+// matching ipRange, and returns its WAPI object reference.
+func findIPv6AllocationObject(client *infoblox.Client, d *schema.ResourceData, ipRange string) (string, error) {
+	target := d.Get("cidr").(string)
+	objectType := "ipv6network"
+	conditions := map[string]string{
+		"network":      target,
+		"network_view": d.Get("network_view").(string),
+	}
+	if ipRange != "" {
+		target = ipRange
+		ips := strings.Split(ipRange, "-")
+		if len(ips) != 2 {
+			return "", fmt.Errorf("[ERROR] ip_range must be of format <start address>-<end address>. Instead found: %s", ipRange)
+		}
+		objectType = "ipv6range"
+		conditions = map[string]string{
+			"start_addr":   ips[0],
+			"end_addr":     ips[1],
+			"network_view": d.Get("network_view").(string),
+		}
+	}
+
+	objects, err := wapiFind(client, objectType, conditions, nil)
+	if err != nil {
+		return "", fmt.Errorf("error finding Infoblox %s %s: %s", objectType, target, err)
+	}
+	if len(objects) == 0 {
+		return "", fmt.Errorf("[ERROR] Empty response from %s search. Is %s a valid %s?", objectType, target, objectType)
+	}
+	return objects[0]["_ref"].(string), nil
+}
+
+// Returns the next num available addresses of the IPv6 network or range with
+// the given WAPI object reference, skipping the excluded addresses.
+func getNextAvailableIPv6(client *infoblox.Client, ref string, excludedAddresses []string, num int) ([]string, error) {
+	args := map[string]interface{}{"num": num}
+	if len(excludedAddresses) > 0 {
+		args["exclude"] = excludedAddresses
+	}
+
+	ou, err := wapiFunction(client, ref, "next_available_ip", args)
+	if err != nil {
+		return nil, err
+	}
+
+	result := getMapValueAsStrings(ou, "ips")
+	if len(result) == 0 {
+		return nil, fmt.Errorf("[ERROR] Unable to determine IP address from response")
+	}
+	return result, nil
+}
+
+// Sets the allocated addresses of the resource, the first of which is also
+// its "ipaddress".
+func setIPAddresses(d *schema.ResourceData, addresses []interface{}) {
+	if len(addresses) > 0 {
+		d.Set("ipaddress", addresses[0])
+	}
+	d.Set("ipaddresses", addresses)
 }
 
 func resourceInfobloxIPRead(d *schema.ResourceData, meta interface{}) error {
+	if isUnreservedIP(d) {
+		log.Printf("[WARN] Infoblox IP %s is not reserved on the grid and may be handed out again, taint the resource to reserve it", d.Id())
+		setIPAddresses(d, []interface{}{d.Id()})
+		return nil
+	}
+
+	client := meta.(*providerMeta).client
+
+	if strings.HasPrefix(d.Id(), "record:host/") {
+		host, err := wapiGet(client, d.Id(), []string{"name", "comment", "ipv4addrs", "ipv6addrs", "extattrs"})
+		if err != nil {
+			return handleReadError(d, "IP host", err)
+		}
+
+		d.Set("name", host["name"])
+		d.Set("comment", host["comment"])
+		d.Set("extensible_attributes", flattenExtAttrs(d, meta, host["extattrs"]))
+
+		var addresses []interface{}
+		for _, family := range []string{"ipv4addr", "ipv6addr"} {
+			addrs, _ := host[family+"s"].([]interface{})
+			for _, v := range addrs {
+				if addr, ok := v.(map[string]interface{}); ok {
+					addresses = append(addresses, addr[family])
+				}
+			}
+		}
+		setIPAddresses(d, addresses)
+
+		return nil
+	}
+
+	if strings.HasPrefix(d.Id(), "ipv6fixedaddress/") {
+		fixedAddress, err := wapiGet(client, d.Id(), []string{"ipv6addr", "duid", "name", "comment", "network_view", "extattrs"})
+		if err != nil {
+			return handleReadError(d, "IP IPv6 fixed address", err)
+		}
 
-	// since the infoblox network object's NextAvailableIP function isn't exactly
-	// a resource (you don't really allocate an IP address until you use the record:a or
-	// record:host object), we don't actually implement READ, UPDATE, or DELETE
+		setIPAddresses(d, []interface{}{fixedAddress["ipv6addr"]})
+		d.Set("duid", fixedAddress["duid"])
+		d.Set("name", fixedAddress["name"])
+		d.Set("comment", fixedAddress["comment"])
+		d.Set("network_view", fixedAddress["network_view"])
+		d.Set("extensible_attributes", flattenExtAttrs(d, meta, fixedAddress["extattrs"]))
+
+		return nil
+	}
+
+	// Reservations deleted outside of terraform are dropped from the ID,
+	// so that only the remaining ones are updated and released, and the
+	// resource is only gone once all of them are.
+	var refs []string
+	var addresses []interface{}
+	for _, ref := range ipReservationRefs(d) {
+		fixedAddress, err := wapiGet(client, ref, []string{"ipv4addr", "mac", "name", "comment", "network_view", "extattrs"})
+		if isNotFoundError(err) {
+			log.Printf("[WARN] Infoblox IP fixed address %s no longer exists", ref)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("Error reading Infoblox IP fixed address record: %s", err)
+		}
+		refs = append(refs, ref)
+		addresses = append(addresses, fixedAddress["ipv4addr"])
+
+		// The reservations of a resource are all created and updated
+		// alike, so the first one stands for the rest.
+		if len(refs) > 1 {
+			continue
+		}
+		d.Set("name", fixedAddress["name"])
+		d.Set("comment", fixedAddress["comment"])
+		d.Set("network_view", fixedAddress["network_view"])
+		d.Set("extensible_attributes", flattenExtAttrs(d, meta, fixedAddress["extattrs"]))
+		if d.Get("reserve_as").(string) == "fixed_address" {
+			d.Set("mac", fixedAddress["mac"])
+		}
+	}
+	d.SetId(strings.Join(refs, ","))
+	setIPAddresses(d, addresses)
 
 	return nil
 }
 
 func resourceInfobloxIPUpdate(d *schema.ResourceData, meta interface{}) error {
+	if isUnreservedIP(d) {
+		return fmt.Errorf(
+			"Infoblox IP %s was allocated without a reservation by an earlier version of the provider and cannot be updated, "+
+				"taint the resource to reserve a new address", d.Id())
+	}
 
-	// since the infoblox network object's NextAvailableIP function isn't exactly
-	// a resource (you don't really allocate an IP address until you use the record:a or
-	// record:host object), we don't actually implement READ, UPDATE, or DELETE
+	family := "ipv4addr"
+	if strings.HasPrefix(d.Id(), "ipv6fixedaddress/") {
+		family = "ipv6addr"
+	}
+	if err := validateIPReservation(d, family); err != nil {
+		return err
+	}
 
-	return nil
+	client := meta.(*providerMeta).client
+
+	extAttrs, err := extAttrsFromResourceData(d, meta)
+	if err != nil {
+		return err
+	}
+
+	update := map[string]interface{}{
+		"name":    d.Get("name").(string),
+		"comment": d.Get("comment").(string),
+	}
+	extAttrs.addTo(update)
+	if strings.HasPrefix(d.Id(), "ipv6fixedaddress/") {
+		update["duid"] = d.Get("duid").(string)
+	} else if d.Get("reserve_as").(string) == "fixed_address" {
+		update["mac"] = d.Get("mac").(string)
+	}
+
+	log.Printf("[DEBUG] Updating Infoblox IP reservation with configuration: %#v", update)
+
+	var refs []string
+	for _, ref := range ipReservationRefs(d) {
+		updated, err := wapiUpdate(client, ref, update)
+		if err != nil {
+			return fmt.Errorf("error updating Infoblox IP reservation: %s", err.Error())
+		}
+		refs = append(refs, updated)
+	}
+
+	d.SetId(strings.Join(refs, ","))
+	log.Printf("[INFO] Infoblox IP reservation updated with ID: %s", d.Id())
+
+	return resourceInfobloxIPRead(d, meta)
 }
 
 func resourceInfobloxIPDelete(d *schema.ResourceData, meta interface{}) error {
+	if isUnreservedIP(d) {
+		return nil
+	}
 
-	// since the infoblox network object's NextAvailableIP function isn't exactly
-	// a resource (you don't really allocate an IP address until you use the record:a or
-	// record:host object), we don't actually implement READ, UPDATE, or DELETE
+	client := meta.(*providerMeta).client
+
+	log.Printf("[DEBUG] Releasing Infoblox IP: %s, %s", d.Get("ipaddress").(string), d.Id())
+	for _, ref := range ipReservationRefs(d) {
+		err := wapiDelete(client, ref)
+		if err != nil && !isNotFoundError(err) {
+			return fmt.Errorf("error releasing Infoblox IP: %s", err.Error())
+		}
+	}
 
 	return nil
 }
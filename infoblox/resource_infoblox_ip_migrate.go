@@ -0,0 +1,46 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// resourceInfobloxIPMigrateState upgrades the state of infoblox_ip resources
+// created by earlier versions of the provider.
+func resourceInfobloxIPMigrateState(v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	switch v {
+	case 0:
+		log.Println("[INFO] Found Infoblox IP state v0; migrating to v1")
+		return migrateInfobloxIPStateV0toV1(is)
+	default:
+		return is, fmt.Errorf("Unexpected schema version: %d", v)
+	}
+}
+
+// Version 0 resources predate "reserve_as" and "num_addresses", which are
+// ForceNew, so without their defaults in the state the first plan would
+// replace every one of them and allocate new addresses. Resources whose ID
+// is their address keep being left alone by isUnreservedIP.
+func migrateInfobloxIPStateV0toV1(is *terraform.InstanceState) (*terraform.InstanceState, error) {
+	if is == nil || is.ID == "" {
+		log.Println("[DEBUG] Empty Infoblox IP state; nothing to migrate.")
+		return is, nil
+	}
+
+	log.Printf("[DEBUG] Infoblox IP attributes before migration: %#v", is.Attributes)
+
+	if is.Attributes == nil {
+		is.Attributes = make(map[string]string)
+	}
+	if _, ok := is.Attributes["reserve_as"]; !ok {
+		is.Attributes["reserve_as"] = "reservation"
+	}
+	if _, ok := is.Attributes["num_addresses"]; !ok {
+		is.Attributes["num_addresses"] = "1"
+	}
+
+	log.Printf("[DEBUG] Infoblox IP attributes after migration: %#v", is.Attributes)
+	return is, nil
+}
@@ -0,0 +1,81 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestInfobloxIPMigrateState(t *testing.T) {
+	cases := map[string]struct {
+		StateVersion int
+		ID           string
+		Attributes   map[string]string
+		Expected     map[string]string
+	}{
+		"v0 unreserved address": {
+			StateVersion: 0,
+			ID:           "10.0.0.5",
+			Attributes: map[string]string{
+				"cidr":      "10.0.0.0/24",
+				"ipaddress": "10.0.0.5",
+			},
+			Expected: map[string]string{
+				"cidr":          "10.0.0.0/24",
+				"ipaddress":     "10.0.0.5",
+				"reserve_as":    "reservation",
+				"num_addresses": "1",
+			},
+		},
+		"v0 reservation keeps its arguments": {
+			StateVersion: 0,
+			ID:           "record:host/ZG5zLmhvc3QkLl9kZWZhdWx0:web.example.com/default",
+			Attributes: map[string]string{
+				"cidr":          "10.0.0.0/24",
+				"reserve_as":    "host",
+				"num_addresses": "2",
+			},
+			Expected: map[string]string{
+				"cidr":          "10.0.0.0/24",
+				"reserve_as":    "host",
+				"num_addresses": "2",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		is := &terraform.InstanceState{
+			ID:         tc.ID,
+			Attributes: tc.Attributes,
+		}
+		is, err := resourceInfobloxIPMigrateState(tc.StateVersion, is, nil)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err)
+		}
+
+		if len(is.Attributes) != len(tc.Expected) {
+			t.Fatalf("%s: expected attributes %#v, got %#v", name, tc.Expected, is.Attributes)
+		}
+		for k, v := range tc.Expected {
+			if is.Attributes[k] != v {
+				t.Fatalf("%s: expected %q for %s, got %q", name, v, k, is.Attributes[k])
+			}
+		}
+	}
+}
+
+func TestInfobloxIPMigrateState_empty(t *testing.T) {
+	var is *terraform.InstanceState
+	is, err := resourceInfobloxIPMigrateState(0, is, nil)
+	if err != nil {
+		t.Fatalf("unexpected error migrating nil state: %s", err)
+	}
+	if is != nil {
+		t.Fatalf("expected nil state, got %#v", is)
+	}
+
+	is = &terraform.InstanceState{}
+	if _, err := resourceInfobloxIPMigrateState(0, is, nil); err != nil {
+		t.Fatalf("unexpected error migrating empty state: %s", err)
+	}
+}
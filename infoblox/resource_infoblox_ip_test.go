@@ -0,0 +1,318 @@
+package infoblox
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestMockInfobloxIP_dhcpRange(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	m.add("network", map[string]interface{}{
+		"network":      "10.0.0.0/24",
+		"network_view": "default",
+	})
+	rangeRef := m.add("range", map[string]interface{}{
+		"start_addr":   "10.0.0.100",
+		"end_addr":     "10.0.0.199",
+		"network_view": "default",
+	})
+
+	testMockLifecycle(t, m, mockLifecycleTest{
+		Resource:   resourceInfobloxIP(),
+		ObjectType: "fixedaddress",
+		Create: map[string]interface{}{
+			"ip_range": rangeRef,
+		},
+		CreateCheck: map[string]string{
+			"ipaddress": "10.0.0.100",
+		},
+	})
+}
+
+// Allocations from a range lock on the network containing it, as they hand
+// out addresses of that network as well.
+func TestMockInfobloxIP_allocationNetwork(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	networkRef := m.add("network", map[string]interface{}{
+		"network":      "10.0.0.0/24",
+		"network_view": "default",
+	})
+	m.add("network", map[string]interface{}{
+		"network":      "10.0.1.0/24",
+		"network_view": "default",
+	})
+
+	client := m.client()
+	r := resourceInfobloxIP()
+	for _, config := range []map[string]interface{}{
+		{"cidr": "10.0.0.0/24", "network_view": "default"},
+		{"ip_range": "10.0.0.100-10.0.0.199", "network_view": "default"},
+	} {
+		d := schema.TestResourceDataRaw(t, r.Schema, config)
+		ipRange := d.Get("ip_range").(string)
+
+		network, err := ipAllocationNetwork(client, d, ipAllocationFamily(d, ipRange), ipRange)
+		if err != nil {
+			t.Fatalf("error finding the network of %v: %s", config, err)
+		}
+		if network != networkRef {
+			t.Fatalf("expected %v to be allocated from %s, got %s", config, networkRef, network)
+		}
+	}
+
+	// The same CIDR in another network view is a different network.
+	otherRef := m.add("network", map[string]interface{}{
+		"network":      "10.0.0.0/24",
+		"network_view": "lab",
+	})
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"cidr":         "10.0.0.0/24",
+		"network_view": "lab",
+	})
+	network, err := ipAllocationNetwork(client, d, "ipv4addr", "")
+	if err != nil {
+		t.Fatalf("error finding the network in view lab: %s", err)
+	}
+	if network != otherRef {
+		t.Fatalf("expected the address to be allocated from %s, got %s", otherRef, network)
+	}
+
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"ip_range":     "10.0.9.100-10.0.9.199",
+		"network_view": "default",
+	})
+	if _, err := ipAllocationNetwork(client, d, "ipv4addr", "10.0.9.100-10.0.9.199"); err == nil {
+		t.Fatalf("expected an error for a range outside of any network")
+	}
+}
+
+func TestMockInfobloxIP_distinctAddresses(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	m.add("network", map[string]interface{}{
+		"network":      "10.0.0.0/24",
+		"network_view": "default",
+	})
+
+	meta := m.meta()
+	r := resourceInfobloxIP()
+
+	// Create the resources in parallel, like terraform's graph walker does.
+	const count = 50
+	data := make([]*schema.ResourceData, count)
+	for i := range data {
+		data[i] = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+			"cidr": "10.0.0.0/24",
+		})
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, count)
+	for _, d := range data {
+		wg.Add(1)
+		go func(d *schema.ResourceData) {
+			defer wg.Done()
+			errs <- r.Create(d, meta)
+		}(d)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("error creating IP: %s", err)
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, d := range data {
+		ip := d.Get("ipaddress").(string)
+		if seen[ip] {
+			t.Fatalf("IP %s was handed out twice", ip)
+		}
+		seen[ip] = true
+	}
+}
+
+// Several addresses reserved at once are held by one fixed address each and
+// released together.
+func TestMockInfobloxIP_numAddresses(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	m.add("network", map[string]interface{}{
+		"network":      "10.0.0.0/24",
+		"network_view": "default",
+	})
+
+	meta := m.meta()
+	r := resourceInfobloxIP()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"cidr":          "10.0.0.0/24",
+		"num_addresses": 3,
+		"comment":       "cluster VIPs",
+	})
+	if err := r.Create(d, meta); err != nil {
+		t.Fatalf("error creating IPs: %s", err)
+	}
+	testMockCheckAttributes(t, d, map[string]string{
+		"ipaddress":   "10.0.0.1",
+		"ipaddresses": "[10.0.0.1 10.0.0.2 10.0.0.3]",
+		"comment":     "cluster VIPs",
+	})
+	if n := m.count("fixedaddress"); n != 3 {
+		t.Fatalf("expected 3 fixed addresses, got %d", n)
+	}
+
+	if err := r.Delete(d, meta); err != nil {
+		t.Fatalf("error releasing IPs: %s", err)
+	}
+	if n := m.count("fixedaddress"); n != 0 {
+		t.Fatalf("expected all fixed addresses to be released, %d left", n)
+	}
+}
+
+// Reservations of a resource which are deleted outside of terraform are
+// dropped from its ID, and the resource is only gone once all of them are.
+func TestMockInfobloxIP_numAddressesDeletedOutside(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	m.add("network", map[string]interface{}{
+		"network":      "10.0.0.0/24",
+		"network_view": "default",
+	})
+
+	meta := m.meta()
+	r := resourceInfobloxIP()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"cidr":          "10.0.0.0/24",
+		"num_addresses": 3,
+	})
+	if err := r.Create(d, meta); err != nil {
+		t.Fatalf("error creating IPs: %s", err)
+	}
+	refs := ipReservationRefs(d)
+
+	if err := wapiDelete(m.client(), refs[0]); err != nil {
+		t.Fatalf("error deleting fixed address: %s", err)
+	}
+	if err := r.Read(d, meta); err != nil {
+		t.Fatalf("error reading IPs: %s", err)
+	}
+	if expected := strings.Join(refs[1:], ","); d.Id() != expected {
+		t.Fatalf("expected ID %q, got %q", expected, d.Id())
+	}
+	testMockCheckAttributes(t, d, map[string]string{
+		"ipaddress":   "10.0.0.2",
+		"ipaddresses": "[10.0.0.2 10.0.0.3]",
+	})
+
+	// Releasing reservations which are already gone is not an error.
+	if err := wapiDelete(m.client(), refs[1]); err != nil {
+		t.Fatalf("error deleting fixed address: %s", err)
+	}
+	if err := r.Delete(d, meta); err != nil {
+		t.Fatalf("error releasing IPs: %s", err)
+	}
+	if n := m.count("fixedaddress"); n != 0 {
+		t.Fatalf("expected all fixed addresses to be released, %d left", n)
+	}
+
+	if err := r.Read(d, meta); err != nil {
+		t.Fatalf("error reading released IPs: %s", err)
+	}
+	if d.Id() != "" {
+		t.Fatalf("released IPs were not removed from the state")
+	}
+}
+
+// IPv6 addresses have no MAC to reserve them with, so a plain reservation is
+// refused before anything is allocated.
+func TestMockInfobloxIP_ipv6Reservation(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	m.add("ipv6network", map[string]interface{}{
+		"network":      "2001:db8::/64",
+		"network_view": "default",
+	})
+
+	r := resourceInfobloxIP()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"cidr": "2001:db8::/64",
+	})
+	if err := r.Create(d, m.meta()); err == nil {
+		t.Fatalf("expected reserving an IPv6 address without a client to fail")
+	}
+	if m.count("ipv6fixedaddress") != 0 {
+		t.Fatalf("expected no IPv6 fixed address to be created")
+	}
+}
+
+// A reservation is not bound to a client, so a MAC address is refused rather
+// than silently ignored, both when creating and when updating it.
+func TestMockInfobloxIP_reservationMAC(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	m.add("network", map[string]interface{}{
+		"network":      "10.0.0.0/24",
+		"network_view": "default",
+	})
+
+	p := m.provider(resourceInfobloxIP())
+	if _, err := testMockApply(t, p, nil, map[string]interface{}{
+		"cidr": "10.0.0.0/24",
+		"mac":  "00:50:56:00:00:01",
+	}); err == nil {
+		t.Fatalf("expected reserving an IP with a MAC address to fail")
+	}
+	if n := m.count("fixedaddress"); n != 0 {
+		t.Fatalf("expected no fixed address to be created, got %d", n)
+	}
+
+	state, err := testMockApply(t, p, nil, map[string]interface{}{
+		"cidr": "10.0.0.0/24",
+	})
+	if err != nil {
+		t.Fatalf("error reserving IP: %s", err)
+	}
+	if _, err := testMockApply(t, p, state, map[string]interface{}{
+		"cidr": "10.0.0.0/24",
+		"mac":  "00:50:56:00:00:01",
+	}); err == nil {
+		t.Fatalf("expected adding a MAC address to a reservation to fail")
+	}
+}
+
+// Addresses allocated without a reservation by earlier versions of the
+// provider cannot be updated, as there is no object on the grid to update.
+func TestMockInfobloxIP_unreservedUpdate(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	r := resourceInfobloxIP()
+	d := r.Data(&terraform.InstanceState{
+		ID: "10.0.0.5",
+		Attributes: map[string]string{
+			"cidr":          "10.0.0.0/24",
+			"ipaddress":     "10.0.0.5",
+			"reserve_as":    "reservation",
+			"num_addresses": "1",
+		},
+	})
+	d.Set("comment", "web server")
+	if err := r.Update(d, m.meta()); err == nil {
+		t.Fatalf("expected updating an unreserved IP to fail")
+	}
+}
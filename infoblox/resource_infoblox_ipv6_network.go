@@ -0,0 +1,194 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func infobloxIPv6Network() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInfobloxIPv6NetworkCreate,
+		Read:   resourceInfobloxIPv6NetworkRead,
+		Update: resourceInfobloxIPv6NetworkUpdate,
+		Delete: resourceInfobloxIPv6NetworkDelete,
+		Importer: &schema.ResourceImporter{
+			State: importInfobloxIPv6Network,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cidr": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"parent_cidr"},
+				ValidateFunc:  validateCIDR,
+			},
+			"parent_cidr": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"cidr"},
+				ValidateFunc:  validateCIDR,
+			},
+			"prefix_length": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+			"network_view": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"comment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"extensible_attributes": extAttrsSchema(),
+		},
+	}
+}
+
+// ipv6NetworkReturnFields lists the fields we read back for IPv6 networks.
+var ipv6NetworkReturnFields = []string{"network", "network_view", "comment", "extattrs"}
+
+// ipv6NetworkObjectFromAttributes builds the body of an ipv6network
+// create or update request from the attributes as set by terraform.
+// The Infoblox WAPI does not allow the network or network_view of an existing
+// IPv6 network to be changed, so we take an isUpdate arg to skip setting them.
+func ipv6NetworkObjectFromAttributes(d *schema.ResourceData, meta interface{}, isUpdate bool) (map[string]interface{}, error) {
+	network := make(map[string]interface{})
+
+	if !isUpdate {
+		if attr, ok := d.GetOk("cidr"); ok {
+			network["network"] = attr.(string)
+		} else {
+			// Let the grid carve the next free subnet out of the parent
+			// container in the same request that creates the network.
+			network["network"] = nextAvailableNetworkFunc(d)
+		}
+		network["network_view"] = d.Get("network_view").(string)
+	}
+
+	network["comment"] = d.Get("comment").(string)
+
+	extAttrs, err := extAttrsFromResourceData(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	extAttrs.addTo(network)
+
+	return network, nil
+}
+
+func resourceInfobloxIPv6NetworkCreate(d *schema.ResourceData, meta interface{}) error {
+	if err := validateNetworkData(d, "IPv6 network"); err != nil {
+		return err
+	}
+
+	setDefaultNetworkView(d, meta)
+	client := meta.(*providerMeta).client
+
+	network, err := ipv6NetworkObjectFromAttributes(d, meta, false)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating Infoblox IPv6 network with configuration: %#v", network)
+
+	networkID, err := wapiCreate(client, "ipv6network", network)
+	if err != nil {
+		return fmt.Errorf("error creating Infoblox IPv6 network: %s", err.Error())
+	}
+
+	d.SetId(networkID)
+	log.Printf("[INFO] Infoblox IPv6 network created with ID: %s", d.Id())
+
+	return resourceInfobloxIPv6NetworkRead(d, meta)
+}
+
+func resourceInfobloxIPv6NetworkRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	network, err := wapiGet(client, d.Id(), ipv6NetworkReturnFields)
+	if err != nil {
+		return handleReadError(d, "IPv6 network", err)
+	}
+
+	d.Set("cidr", network["network"])
+	d.Set("network_view", network["network_view"])
+	d.Set("comment", network["comment"])
+	d.Set("extensible_attributes", flattenExtAttrs(d, meta, network["extattrs"]))
+
+	return nil
+}
+
+func resourceInfobloxIPv6NetworkUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	network, err := ipv6NetworkObjectFromAttributes(d, meta, true)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Infoblox IPv6 network with configuration: %#v", network)
+
+	networkID, err := wapiUpdate(client, d.Id(), network)
+	if err != nil {
+		return fmt.Errorf("error updating Infoblox IPv6 network: %s", err.Error())
+	}
+
+	d.SetId(networkID)
+	log.Printf("[INFO] Infoblox IPv6 network updated with ID: %s", d.Id())
+
+	return resourceInfobloxIPv6NetworkRead(d, meta)
+}
+
+func resourceInfobloxIPv6NetworkDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	log.Printf("[DEBUG] Deleting Infoblox IPv6 network: %s, %s", d.Get("cidr").(string), d.Id())
+
+	err := wapiDelete(client, d.Id())
+	if err != nil && !isNotFoundError(err) {
+		return fmt.Errorf("error deleting Infoblox IPv6 network: %s", err.Error())
+	}
+
+	return nil
+}
+
+// importInfobloxIPv6Network accepts either the WAPI object reference of an
+// IPv6 network or a "<network_view>/<cidr>" ID such as "default/2001:db8::/64".
+func importInfobloxIPv6Network(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if strings.HasPrefix(d.Id(), "ipv6network/") {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	client := meta.(*providerMeta).client
+
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf(
+			"invalid import ID %q: must be a WAPI object reference or of the form <network_view>/<cidr>", d.Id())
+	}
+
+	networks, err := wapiFind(client, "ipv6network", map[string]string{
+		"network_view": parts[0],
+		"network":      parts[1],
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error finding Infoblox IPv6 network %s: %s", d.Id(), err)
+	}
+	if len(networks) != 1 {
+		return nil, fmt.Errorf("expected one Infoblox IPv6 network matching %s, found %d", d.Id(), len(networks))
+	}
+
+	d.SetId(networks[0]["_ref"].(string))
+
+	return []*schema.ResourceData{d}, nil
+}
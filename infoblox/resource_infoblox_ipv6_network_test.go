@@ -0,0 +1,27 @@
+package infoblox
+
+import (
+	"testing"
+)
+
+func TestMockInfobloxIPv6NetworkImport(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	ref := m.add("ipv6network", map[string]interface{}{
+		"network":      "2001:db8::/64",
+		"network_view": "default",
+	})
+
+	r := infobloxIPv6Network()
+	d := r.TestResourceData()
+	d.SetId("default/2001:db8::/64")
+
+	imported, err := r.Importer.State(d, m.meta())
+	if err != nil {
+		t.Fatalf("error importing IPv6 network: %s", err)
+	}
+	if imported[0].Id() != ref {
+		t.Fatalf("expected import to resolve to %s, got %s", ref, imported[0].Id())
+	}
+}
@@ -0,0 +1,380 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	infoblox "github.com/fanatic/go-infoblox"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// dhcpMemberSchema represents the schema for a DHCP member serving a network
+func dhcpMemberSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"ipv4addr": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateIPv4Address,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+}
+
+// dhcpOptionSchema represents the schema for a DHCP option
+func dhcpOptionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"num": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"value": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"vendor_class": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "DHCP",
+		},
+		"use_option": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+}
+
+func infobloxNetwork() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInfobloxNetworkCreate,
+		Read:   resourceInfobloxNetworkRead,
+		Update: resourceInfobloxNetworkUpdate,
+		Delete: resourceInfobloxNetworkDelete,
+		Importer: &schema.ResourceImporter{
+			State: importInfobloxNetwork,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cidr": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"parent_cidr"},
+				ValidateFunc:  validateCIDR,
+			},
+			"parent_cidr": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"cidr"},
+				ValidateFunc:  validateCIDR,
+			},
+			"prefix_length": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+			"network_view": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"comment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"extensible_attributes": extAttrsSchema(),
+			"member": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Resource{Schema: dhcpMemberSchema()},
+			},
+			"option": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Resource{Schema: dhcpOptionSchema()},
+			},
+		},
+	}
+}
+
+// networkReturnFields lists the fields we read back for network objects.
+var networkReturnFields = []string{"network", "network_view", "comment", "extattrs", "members", "options"}
+
+// Validates that either 'cidr' or 'parent_cidr' and 'prefix_length' are set.
+// objectName names the kind of network being created in error messages.
+func validateNetworkData(d *schema.ResourceData, objectName string) error {
+	_, cidrOk := d.GetOk("cidr")
+	_, parentOk := d.GetOk("parent_cidr")
+	_, prefixOk := d.GetOk("prefix_length")
+
+	if !cidrOk && !parentOk {
+		return fmt.Errorf(
+			"One of ['cidr', 'parent_cidr'] must be set to create an Infoblox %s", objectName)
+	}
+	if parentOk && !prefixOk {
+		return fmt.Errorf("'prefix_length' must be set when allocating a %s from 'parent_cidr'", objectName)
+	}
+	return nil
+}
+
+// nextAvailableNetworkFunc returns the WAPI function which allocates the next
+// available network of the resource's prefix_length from its parent_cidr when
+// used as the network of a create request.
+func nextAvailableNetworkFunc(d *schema.ResourceData) string {
+	return fmt.Sprintf("func:nextavailablenetwork:%s,%s,%d",
+		d.Get("parent_cidr").(string), d.Get("network_view").(string), d.Get("prefix_length").(int))
+}
+
+func dhcpMembersFromList(members []interface{}) []map[string]interface{} {
+	// WAPI rejects null lists, so always send at least an empty one.
+	result := make([]map[string]interface{}, 0, len(members))
+
+	for _, v := range members {
+		memberMap := v.(map[string]interface{})
+		m := map[string]interface{}{"_struct": "dhcpmember"}
+
+		if val, ok := memberMap["ipv4addr"]; ok && val.(string) != "" {
+			m["ipv4addr"] = val.(string)
+		}
+		if val, ok := memberMap["name"]; ok && val.(string) != "" {
+			m["name"] = val.(string)
+		}
+
+		result = append(result, m)
+	}
+	return result
+}
+
+func dhcpOptionsFromList(options []interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(options))
+
+	for _, v := range options {
+		optionMap := v.(map[string]interface{})
+		o := map[string]interface{}{
+			"name":         optionMap["name"].(string),
+			"value":        optionMap["value"].(string),
+			"vendor_class": optionMap["vendor_class"].(string),
+			"use_option":   optionMap["use_option"].(bool),
+		}
+		if val, ok := optionMap["num"]; ok && val.(int) != 0 {
+			o["num"] = val.(int)
+		}
+
+		result = append(result, o)
+	}
+	return result
+}
+
+func flattenDHCPMembers(members interface{}) []interface{} {
+	var result []interface{}
+
+	list, _ := members.([]interface{})
+	for _, v := range list {
+		member, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		m := make(map[string]interface{})
+		if val, ok := member["ipv4addr"]; ok {
+			m["ipv4addr"] = val
+		}
+		if val, ok := member["name"]; ok {
+			m["name"] = val
+		}
+		result = append(result, m)
+	}
+	return result
+}
+
+func flattenDHCPOptions(options interface{}) []interface{} {
+	var result []interface{}
+
+	list, _ := options.([]interface{})
+	for _, v := range list {
+		option, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		o := map[string]interface{}{
+			"name":         option["name"],
+			"value":        option["value"],
+			"vendor_class": option["vendor_class"],
+			"use_option":   option["use_option"],
+		}
+		if num, ok := wapiInt(option["num"]); ok {
+			o["num"] = num
+		}
+		result = append(result, o)
+	}
+	return result
+}
+
+// networkObjectFromAttributes builds the body of a network create or update
+// request from the attributes as set by terraform.
+// The Infoblox WAPI does not allow the network or network_view of an existing
+// network to be changed, so we take an isUpdate arg to skip setting them.
+func networkObjectFromAttributes(d *schema.ResourceData, meta interface{}, isUpdate bool) (map[string]interface{}, error) {
+	network := make(map[string]interface{})
+
+	if !isUpdate {
+		if attr, ok := d.GetOk("cidr"); ok {
+			network["network"] = attr.(string)
+		} else {
+			// Let the grid carve the next free subnet out of the parent
+			// container in the same request that creates the network.
+			network["network"] = nextAvailableNetworkFunc(d)
+		}
+		network["network_view"] = d.Get("network_view").(string)
+	}
+
+	network["comment"] = d.Get("comment").(string)
+	network["members"] = dhcpMembersFromList(d.Get("member").([]interface{}))
+	network["options"] = dhcpOptionsFromList(d.Get("option").([]interface{}))
+
+	extAttrs, err := extAttrsFromResourceData(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	extAttrs.addTo(network)
+
+	return network, nil
+}
+
+func resourceInfobloxNetworkCreate(d *schema.ResourceData, meta interface{}) error {
+	if err := validateNetworkData(d, "network"); err != nil {
+		return err
+	}
+
+	setDefaultNetworkView(d, meta)
+	client := meta.(*providerMeta).client
+
+	record := url.Values{}
+	networkObject, err := networkObjectFromAttributes(d, meta, false)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating Infoblox network with configuration: %#v", networkObject)
+
+	opts := &infoblox.Options{
+		ReturnFields: networkReturnFields,
+	}
+	networkID, err := client.Network().Create(record, opts, networkObject)
+	if err != nil {
+		return fmt.Errorf("error creating Infoblox network: %s", err.Error())
+	}
+
+	d.SetId(networkID)
+	log.Printf("[INFO] Infoblox network created with ID: %s", d.Id())
+
+	return resourceInfobloxNetworkRead(d, meta)
+}
+
+func resourceInfobloxNetworkRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	// wapiGet keeps the infoblox.Error of WAPI, which NetworkObject().Get
+	// flattens into a string, so that deleted networks are recognised.
+	network, err := wapiGet(client, d.Id(), networkReturnFields)
+	if err != nil {
+		return handleReadError(d, "network", err)
+	}
+
+	d.Set("cidr", network["network"])
+	d.Set("network_view", network["network_view"])
+	d.Set("comment", network["comment"])
+	d.Set("extensible_attributes", flattenExtAttrs(d, meta, network["extattrs"]))
+	d.Set("member", flattenDHCPMembers(network["members"]))
+	d.Set("option", flattenDHCPOptions(network["options"]))
+
+	return nil
+}
+
+func resourceInfobloxNetworkUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	networkObject, err := networkObjectFromAttributes(d, meta, true)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Infoblox network with configuration: %#v", networkObject)
+
+	networkID, err := wapiUpdate(client, d.Id(), networkObject)
+	if err != nil {
+		return fmt.Errorf("error updating Infoblox network: %s", err.Error())
+	}
+
+	d.SetId(networkID)
+	log.Printf("[INFO] Infoblox network updated with ID: %s", d.Id())
+
+	return resourceInfobloxNetworkRead(d, meta)
+}
+
+func resourceInfobloxNetworkDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	log.Printf("[DEBUG] Deleting Infoblox network: %s, %s", d.Get("cidr").(string), d.Id())
+	err := wapiDelete(client, d.Id())
+	if err != nil && !isNotFoundError(err) {
+		return fmt.Errorf("error deleting Infoblox network: %s", err.Error())
+	}
+
+	return nil
+}
+
+// importInfobloxNetwork accepts either the WAPI object reference of a network
+// or a "<network_view>/<cidr>" ID such as "default/10.0.0.0/24".
+func importInfobloxNetwork(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if strings.HasPrefix(d.Id(), "network/") {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	client := meta.(*providerMeta).client
+
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf(
+			"invalid import ID %q: must be a WAPI object reference or of the form <network_view>/<cidr>", d.Id())
+	}
+
+	networkViewField := "network_view"
+	networkField := "network"
+	q := []infoblox.Condition{
+		infoblox.Condition{
+			Field: &networkViewField,
+			Value: parts[0],
+		},
+		infoblox.Condition{
+			Field: &networkField,
+			Value: parts[1],
+		},
+	}
+
+	networks, err := client.Network().Find(q, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error finding Infoblox network %s: %s", d.Id(), err)
+	}
+	if len(networks) != 1 {
+		return nil, fmt.Errorf("expected one Infoblox network matching %s, found %d", d.Id(), len(networks))
+	}
+
+	d.SetId(networks[0]["_ref"].(string))
+
+	return []*schema.ResourceData{d}, nil
+}
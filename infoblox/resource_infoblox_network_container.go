@@ -0,0 +1,195 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func infobloxNetworkContainer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInfobloxNetworkContainerCreate,
+		Read:   resourceInfobloxNetworkContainerRead,
+		Update: resourceInfobloxNetworkContainerUpdate,
+		Delete: resourceInfobloxNetworkContainerDelete,
+		Importer: &schema.ResourceImporter{
+			State: importInfobloxNetworkContainer,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cidr": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"parent_cidr"},
+				ValidateFunc:  validateCIDR,
+			},
+			"parent_cidr": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"cidr"},
+				ValidateFunc:  validateCIDR,
+			},
+			"prefix_length": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+			"network_view": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"comment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"extensible_attributes": extAttrsSchema(),
+		},
+	}
+}
+
+// networkContainerReturnFields lists the fields we read back for network
+// containers.
+var networkContainerReturnFields = []string{"network", "network_view", "comment", "extattrs"}
+
+// networkContainerObjectFromAttributes builds the body of a networkcontainer
+// create or update request from the attributes as set by terraform.
+// The Infoblox WAPI does not allow the network or network_view of an existing
+// container to be changed, so we take an isUpdate arg to skip setting them.
+func networkContainerObjectFromAttributes(d *schema.ResourceData, meta interface{}, isUpdate bool) (map[string]interface{}, error) {
+	container := make(map[string]interface{})
+
+	if !isUpdate {
+		if attr, ok := d.GetOk("cidr"); ok {
+			container["network"] = attr.(string)
+		} else {
+			// Let the grid carve the next free subnet out of the parent
+			// container in the same request that creates the container.
+			container["network"] = nextAvailableNetworkFunc(d)
+		}
+		container["network_view"] = d.Get("network_view").(string)
+	}
+
+	container["comment"] = d.Get("comment").(string)
+
+	extAttrs, err := extAttrsFromResourceData(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	extAttrs.addTo(container)
+
+	return container, nil
+}
+
+func resourceInfobloxNetworkContainerCreate(d *schema.ResourceData, meta interface{}) error {
+	if err := validateNetworkData(d, "network container"); err != nil {
+		return err
+	}
+
+	setDefaultNetworkView(d, meta)
+	client := meta.(*providerMeta).client
+
+	container, err := networkContainerObjectFromAttributes(d, meta, false)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating Infoblox network container with configuration: %#v", container)
+
+	containerID, err := wapiCreate(client, "networkcontainer", container)
+	if err != nil {
+		return fmt.Errorf("error creating Infoblox network container: %s", err.Error())
+	}
+
+	d.SetId(containerID)
+	log.Printf("[INFO] Infoblox network container created with ID: %s", d.Id())
+
+	return resourceInfobloxNetworkContainerRead(d, meta)
+}
+
+func resourceInfobloxNetworkContainerRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	container, err := wapiGet(client, d.Id(), networkContainerReturnFields)
+	if err != nil {
+		return handleReadError(d, "network container", err)
+	}
+
+	d.Set("cidr", container["network"])
+	d.Set("network_view", container["network_view"])
+	d.Set("comment", container["comment"])
+	d.Set("extensible_attributes", flattenExtAttrs(d, meta, container["extattrs"]))
+
+	return nil
+}
+
+func resourceInfobloxNetworkContainerUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	container, err := networkContainerObjectFromAttributes(d, meta, true)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Infoblox network container with configuration: %#v", container)
+
+	containerID, err := wapiUpdate(client, d.Id(), container)
+	if err != nil {
+		return fmt.Errorf("error updating Infoblox network container: %s", err.Error())
+	}
+
+	d.SetId(containerID)
+	log.Printf("[INFO] Infoblox network container updated with ID: %s", d.Id())
+
+	return resourceInfobloxNetworkContainerRead(d, meta)
+}
+
+func resourceInfobloxNetworkContainerDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	log.Printf("[DEBUG] Deleting Infoblox network container: %s, %s", d.Get("cidr").(string), d.Id())
+
+	err := wapiDelete(client, d.Id())
+	if err != nil && !isNotFoundError(err) {
+		return fmt.Errorf("error deleting Infoblox network container: %s", err.Error())
+	}
+
+	return nil
+}
+
+// importInfobloxNetworkContainer accepts either the WAPI object reference of a
+// network container or a "<network_view>/<cidr>" ID such as "default/10.0.0.0/16".
+func importInfobloxNetworkContainer(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if strings.HasPrefix(d.Id(), "networkcontainer/") {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	client := meta.(*providerMeta).client
+
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf(
+			"invalid import ID %q: must be a WAPI object reference or of the form <network_view>/<cidr>", d.Id())
+	}
+
+	containers, err := wapiFind(client, "networkcontainer", map[string]string{
+		"network_view": parts[0],
+		"network":      parts[1],
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error finding Infoblox network container %s: %s", d.Id(), err)
+	}
+	if len(containers) != 1 {
+		return nil, fmt.Errorf("expected one Infoblox network container matching %s, found %d", d.Id(), len(containers))
+	}
+
+	d.SetId(containers[0]["_ref"].(string))
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,58 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// A container, a network carved from it and the first address of the network
+// can all be created in one apply by chaining their cidr attributes.
+func TestMockInfobloxNetworkContainer_segment(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	meta := m.meta()
+
+	container := infobloxNetworkContainer()
+	cd := schema.TestResourceDataRaw(t, container.Schema, map[string]interface{}{
+		"cidr": "10.20.0.0/16",
+	})
+	if err := container.Create(cd, meta); err != nil {
+		t.Fatalf("error creating network container: %s", err)
+	}
+
+	network := infobloxNetwork()
+	nd := schema.TestResourceDataRaw(t, network.Schema, map[string]interface{}{
+		"parent_cidr":   cd.Get("cidr").(string),
+		"prefix_length": 26,
+	})
+	if err := network.Create(nd, meta); err != nil {
+		t.Fatalf("error creating network: %s", err)
+	}
+	testMockCheckAttributes(t, nd, map[string]string{
+		"cidr": "10.20.0.0/26",
+	})
+
+	ip := resourceInfobloxIP()
+	id := schema.TestResourceDataRaw(t, ip.Schema, map[string]interface{}{
+		"cidr": nd.Get("cidr").(string),
+	})
+	if err := ip.Create(id, meta); err != nil {
+		t.Fatalf("error creating IP: %s", err)
+	}
+	testMockCheckAttributes(t, id, map[string]string{
+		"ipaddress": "10.20.0.1",
+	})
+
+	// Destroying the segment releases the network back to the container.
+	if err := ip.Delete(id, meta); err != nil {
+		t.Fatalf("error deleting IP: %s", err)
+	}
+	if err := network.Delete(nd, meta); err != nil {
+		t.Fatalf("error deleting network: %s", err)
+	}
+	if n := m.count("network"); n != 0 {
+		t.Fatalf("expected the network to be released, found %d networks", n)
+	}
+}
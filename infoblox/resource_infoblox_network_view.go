@@ -0,0 +1,146 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func infobloxNetworkView() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInfobloxNetworkViewCreate,
+		Read:   resourceInfobloxNetworkViewRead,
+		Update: resourceInfobloxNetworkViewUpdate,
+		Delete: resourceInfobloxNetworkViewDelete,
+		Importer: &schema.ResourceImporter{
+			State: importInfobloxNetworkView,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateViewName,
+			},
+			"comment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"extensible_attributes": extAttrsSchema(),
+		},
+	}
+}
+
+// networkViewReturnFields lists the fields we read back for network views.
+var networkViewReturnFields = []string{"name", "comment", "extattrs"}
+
+// networkViewObjectFromAttributes builds the body of a networkview create or
+// update request from the attributes as set by terraform.
+func networkViewObjectFromAttributes(d *schema.ResourceData, meta interface{}) (map[string]interface{}, error) {
+	networkView := map[string]interface{}{
+		"name":    d.Get("name").(string),
+		"comment": d.Get("comment").(string),
+	}
+
+	extAttrs, err := extAttrsFromResourceData(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	extAttrs.addTo(networkView)
+
+	return networkView, nil
+}
+
+func resourceInfobloxNetworkViewCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	networkView, err := networkViewObjectFromAttributes(d, meta)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating Infoblox network view with configuration: %#v", networkView)
+
+	networkViewID, err := wapiCreate(client, "networkview", networkView)
+	if err != nil {
+		return fmt.Errorf("error creating Infoblox network view: %s", err.Error())
+	}
+
+	d.SetId(networkViewID)
+	log.Printf("[INFO] Infoblox network view created with ID: %s", d.Id())
+
+	return resourceInfobloxNetworkViewRead(d, meta)
+}
+
+func resourceInfobloxNetworkViewRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	networkView, err := wapiGet(client, d.Id(), networkViewReturnFields)
+	if err != nil {
+		return handleReadError(d, "network view", err)
+	}
+
+	d.Set("name", networkView["name"])
+	d.Set("comment", networkView["comment"])
+	d.Set("extensible_attributes", flattenExtAttrs(d, meta, networkView["extattrs"]))
+
+	return nil
+}
+
+func resourceInfobloxNetworkViewUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	networkView, err := networkViewObjectFromAttributes(d, meta)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Infoblox network view with configuration: %#v", networkView)
+
+	networkViewID, err := wapiUpdate(client, d.Id(), networkView)
+	if err != nil {
+		return fmt.Errorf("error updating Infoblox network view: %s", err.Error())
+	}
+
+	d.SetId(networkViewID)
+	log.Printf("[INFO] Infoblox network view updated with ID: %s", d.Id())
+
+	return resourceInfobloxNetworkViewRead(d, meta)
+}
+
+func resourceInfobloxNetworkViewDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	log.Printf("[DEBUG] Deleting Infoblox network view: %s, %s", d.Get("name").(string), d.Id())
+
+	err := wapiDelete(client, d.Id())
+	if err != nil && !isNotFoundError(err) {
+		return fmt.Errorf("error deleting Infoblox network view: %s", err.Error())
+	}
+
+	return nil
+}
+
+// importInfobloxNetworkView accepts either the WAPI object reference of a
+// network view or its name.
+func importInfobloxNetworkView(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if strings.HasPrefix(d.Id(), "networkview/") {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	client := meta.(*providerMeta).client
+
+	networkViews, err := wapiFind(client, "networkview", map[string]string{"name": d.Id()}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error finding Infoblox network view %s: %s", d.Id(), err)
+	}
+	if len(networkViews) != 1 {
+		return nil, fmt.Errorf("expected one Infoblox network view matching %s, found %d", d.Id(), len(networkViews))
+	}
+
+	d.SetId(networkViews[0]["_ref"].(string))
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,26 @@
+package infoblox
+
+import (
+	"testing"
+)
+
+func TestMockInfobloxNetworkViewImport(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	ref := m.add("networkview", map[string]interface{}{
+		"name": "acme",
+	})
+
+	r := infobloxNetworkView()
+	d := r.TestResourceData()
+	d.SetId("acme")
+
+	imported, err := r.Importer.State(d, m.meta())
+	if err != nil {
+		t.Fatalf("error importing network view: %s", err)
+	}
+	if imported[0].Id() != ref {
+		t.Fatalf("expected import to resolve to %s, got %s", ref, imported[0].Id())
+	}
+}
@@ -23,15 +23,17 @@ func resourceInfobloxRecord() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"domain": &schema.Schema{
-				Type:       schema.TypeString,
-				Required:   true,
-				ForceNew:   true,
-				Deprecated: deprecated,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Deprecated:   deprecated,
+				ValidateFunc: validateFQDN,
 			},
 
 			"name": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateRecordName,
 			},
 
 			"value": &schema.Schema{
@@ -40,27 +42,30 @@ func resourceInfobloxRecord() *schema.Resource {
 			},
 
 			"type": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateLegacyRecordType,
 			},
 
 			"ttl": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "3600",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "3600",
+				ValidateFunc: validateTTLString,
 			},
 
 			"view": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
-				Default:  "default",
+				Computed: true,
 			},
 		},
 	}
 }
 
 func resourceInfobloxRecordCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*infoblox.Client)
+	setDefaultView(d, meta)
+	client := meta.(*providerMeta).client
 
 	record := url.Values{}
 	if err := getAll(d, record); err != nil {
@@ -103,7 +108,7 @@ func resourceInfobloxRecordCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceInfobloxRecordRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*infoblox.Client)
+	client := meta.(*providerMeta).client
 
 	switch strings.ToUpper(d.Get("type").(string)) {
 	case "A":
@@ -114,9 +119,7 @@ func resourceInfobloxRecordRead(d *schema.ResourceData, meta interface{}) error
 
 		d.Set("value", rec.Ipv4Addr)
 		d.Set("type", "A")
-		fqdn := strings.Split(rec.Name, ".")
-		d.Set("name", fqdn[0])
-		d.Set("domain", strings.Join(fqdn[1:], "."))
+		setLegacyRecordName(d, rec.Name)
 		d.Set("ttl", rec.Ttl)
 		d.Set("view", rec.View)
 
@@ -127,9 +130,7 @@ func resourceInfobloxRecordRead(d *schema.ResourceData, meta interface{}) error
 		}
 		d.Set("value", rec.Ipv6Addr)
 		d.Set("type", "AAAA")
-		fqdn := strings.Split(rec.Name, ".")
-		d.Set("name", fqdn[0])
-		d.Set("domain", strings.Join(fqdn[1:], "."))
+		setLegacyRecordName(d, rec.Name)
 		d.Set("ttl", rec.Ttl)
 		d.Set("view", rec.View)
 
@@ -140,9 +141,7 @@ func resourceInfobloxRecordRead(d *schema.ResourceData, meta interface{}) error
 		}
 		d.Set("value", rec.Canonical)
 		d.Set("type", "CNAME")
-		fqdn := strings.Split(rec.Name, ".")
-		d.Set("name", fqdn[0])
-		d.Set("domain", strings.Join(fqdn[1:], "."))
+		setLegacyRecordName(d, rec.Name)
 		d.Set("ttl", rec.Ttl)
 		d.Set("view", rec.View)
 	default:
@@ -152,8 +151,24 @@ func resourceInfobloxRecordRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+// setLegacyRecordName splits the FQDN of a record into its name and domain.
+// Names inside the configured domain keep all the labels in front of it, so
+// that multi-label names such as www.eu in the domain example.com survive.
+func setLegacyRecordName(d *schema.ResourceData, fqdn string) {
+	if domain := d.Get("domain").(string); domain != "" {
+		if name := relativeRecordName(fqdn, domain); name != fqdn && name != "@" {
+			d.Set("name", name)
+			return
+		}
+	}
+
+	labels := strings.Split(fqdn, ".")
+	d.Set("name", labels[0])
+	d.Set("domain", strings.Join(labels[1:], "."))
+}
+
 func resourceInfobloxRecordUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*infoblox.Client)
+	client := meta.(*providerMeta).client
 	var recID string
 	var err, updateErr error
 	switch strings.ToUpper(d.Get("type").(string)) {
@@ -208,43 +223,18 @@ func resourceInfobloxRecordUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceInfobloxRecordDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*infoblox.Client)
+	client := meta.(*providerMeta).client
 
 	log.Printf("[INFO] Deleting Infoblox Record: %s, %s", d.Get("name").(string), d.Id())
-	switch strings.ToUpper(d.Get("type").(string)) {
-	case "A":
-		_, err := client.GetRecordA(d.Id(), nil)
-		if err != nil {
-			return fmt.Errorf("Couldn't find Infoblox A record: %s", err)
-		}
-
-		deleteErr := client.RecordAObject(d.Id()).Delete(nil)
-		if deleteErr != nil {
-			return fmt.Errorf("Error deleting Infoblox A Record: %s", deleteErr)
-		}
-	case "AAAA":
-		_, err := client.GetRecordAAAA(d.Id(), nil)
-		if err != nil {
-			return fmt.Errorf("Couldn't find Infoblox AAAA record: %s", err)
-		}
-
-		deleteErr := client.RecordAAAAObject(d.Id()).Delete(nil)
-		if deleteErr != nil {
-			return fmt.Errorf("Error deleting Infoblox AAAA Record: %s", deleteErr)
-		}
-	case "CNAME":
-		_, err := client.GetRecordCname(d.Id(), nil)
-		if err != nil {
-			return fmt.Errorf("Couldn't find Infoblox CNAME record: %s", err)
-		}
-
-		deleteErr := client.RecordCnameObject(d.Id()).Delete(nil)
-		if deleteErr != nil {
-			return fmt.Errorf("Error deleting Infoblox CNAME Record: %s", deleteErr)
-		}
-	default:
+	recordType := strings.ToUpper(d.Get("type").(string))
+	if _, ok := legacyRecordTypes[recordType]; !ok {
 		return fmt.Errorf("resourceInfobloxRecordDelete: unknown type")
 	}
+
+	err := wapiDelete(client, d.Id())
+	if err != nil && !isNotFoundError(err) {
+		return fmt.Errorf("Error deleting Infoblox %s Record: %s", recordType, err)
+	}
 	return nil
 }
 
@@ -270,16 +260,25 @@ func getAll(d *schema.ResourceData, record url.Values) error {
 		value = attr.(string)
 	}
 
+	// The schema can't validate the value, as its format depends on the type.
+	var validate func(interface{}, string) ([]string, []error)
 	switch strings.ToUpper(d.Get("type").(string)) {
 	case "A":
 		record.Set("ipv4addr", value)
+		validate = validateIPv4Address
 	case "AAAA":
 		record.Set("ipv6addr", value)
+		validate = validateIPv6Address
 	case "CNAME":
 		record.Set("canonical", value)
+		validate = validateFQDN
 	default:
 		return fmt.Errorf("getAll: type not found")
 	}
 
+	if _, errors := validate(value, "value"); len(errors) > 0 {
+		return errors[0]
+	}
+
 	return nil
 }
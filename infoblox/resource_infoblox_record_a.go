@@ -15,36 +15,64 @@ func infobloxRecordA() *schema.Resource {
 		Read:   resourceInfobloxARecordRead,
 		Update: resourceInfobloxARecordUpdate,
 		Delete: resourceInfobloxARecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: importInfobloxRecord("record:a", "ipv4addr"),
+		},
 
 		Schema: map[string]*schema.Schema{
-			// TODO: validate that address is in IPv4 format.
 			"address": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIPv4Address,
 			},
 			"name": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRecordName,
+			},
+			"zone": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateFQDN,
 			},
 			"comment": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
 			},
 			"ttl": &schema.Schema{
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateTTL,
 			},
 			"view": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
-				Default:  "default",
+				Computed: true,
+			},
+			"create_ptr": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
+			"ptr_ref": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"extensible_attributes": extAttrsSchema(),
 		},
 	}
 }
 
+// aRecordBody adds the extattrs fields, which infoblox.RecordAObject lacks, to
+// the body of A record create and update requests.
+type aRecordBody struct {
+	infoblox.RecordAObject
+	extAttrFields
+}
+
 // aObjectFromAttributes created an infoblox.RecordAObject using the attributes
 // as set by terraform.
 // The Infoblox WAPI does not allow updates to the "view" field on an A record,
@@ -52,7 +80,7 @@ func infobloxRecordA() *schema.Resource {
 func aObjectFromAttributes(d *schema.ResourceData, skipView bool) infoblox.RecordAObject {
 	aObject := infoblox.RecordAObject{}
 
-	aObject.Name = d.Get("name").(string)
+	aObject.Name = recordFQDN(d)
 	aObject.Ipv4Addr = d.Get("address").(string)
 
 	if attr, ok := d.GetOk("comment"); ok {
@@ -73,7 +101,12 @@ func aObjectFromAttributes(d *schema.ResourceData, skipView bool) infoblox.Recor
 }
 
 func resourceInfobloxARecordCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*infoblox.Client)
+	setDefaultView(d, meta)
+	client := meta.(*providerMeta).client
+
+	if err := validateRecordZone(d, meta); err != nil {
+		return err
+	}
 
 	record := url.Values{}
 	aRecordObject := aObjectFromAttributes(d, false)
@@ -83,7 +116,11 @@ func resourceInfobloxARecordCreate(d *schema.ResourceData, meta interface{}) err
 	opts := &infoblox.Options{
 		ReturnFields: []string{"ipv4addr", "name", "comment", "ttl", "view"},
 	}
-	recordID, err := client.RecordA().Create(record, opts, aRecordObject)
+	extAttrs, err := extAttrsFromResourceData(d, meta)
+	if err != nil {
+		return err
+	}
+	recordID, err := client.RecordA().Create(record, opts, aRecordBody{aRecordObject, extAttrs})
 	if err != nil {
 		return fmt.Errorf("error creating infoblox A record: %s", err.Error())
 	}
@@ -91,11 +128,24 @@ func resourceInfobloxARecordCreate(d *schema.ResourceData, meta interface{}) err
 	d.SetId(recordID)
 	log.Printf("[INFO] Infoblox A record created with ID: %s", d.Id())
 
+	if err := syncManagedPTR(d, meta); err != nil {
+		return err
+	}
+
 	return resourceInfobloxARecordRead(d, meta)
 }
 
 func resourceInfobloxARecordRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*infoblox.Client)
+	if err := readARecord(d, meta, setRecordName); err != nil || d.Id() == "" {
+		return err
+	}
+	return readManagedPTR(d, meta)
+}
+
+// readARecord reads the A record of a resource or data source, which set
+// the name of the record with setName.
+func readARecord(d *schema.ResourceData, meta interface{}, setName func(*schema.ResourceData, string)) error {
+	client := meta.(*providerMeta).client
 
 	opts := &infoblox.Options{
 		ReturnFields: []string{"ipv4addr", "name", "comment", "ttl", "view"},
@@ -106,16 +156,20 @@ func resourceInfobloxARecordRead(d *schema.ResourceData, meta interface{}) error
 	}
 
 	d.Set("address", record.Ipv4Addr)
-	d.Set("name", record.Name)
+	setName(d, record.Name)
 	d.Set("comment", record.Comment)
 	d.Set("ttl", record.Ttl)
 	d.Set("view", record.View)
 
+	if err := readExtAttrs(d, meta, d.Id()); err != nil {
+		return handleReadError(d, "A", err)
+	}
+
 	return nil
 }
 
 func resourceInfobloxARecordUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*infoblox.Client)
+	client := meta.(*providerMeta).client
 
 	opts := &infoblox.Options{
 		ReturnFields: []string{"ipv4addr", "name", "comment", "ttl", "view"},
@@ -130,7 +184,11 @@ func resourceInfobloxARecordUpdate(d *schema.ResourceData, meta interface{}) err
 
 	log.Printf("[DEBUG] Updating Infoblox A record with configuration: %#v", record)
 
-	recordID, err := client.RecordAObject(d.Id()).Update(record, opts, aRecordObject)
+	extAttrs, err := extAttrsFromResourceData(d, meta)
+	if err != nil {
+		return err
+	}
+	recordID, err := client.RecordAObject(d.Id()).Update(record, opts, aRecordBody{aRecordObject, extAttrs})
 	if err != nil {
 		return fmt.Errorf("error updating Infoblox A record: %s", err.Error())
 	}
@@ -138,20 +196,23 @@ func resourceInfobloxARecordUpdate(d *schema.ResourceData, meta interface{}) err
 	d.SetId(recordID)
 	log.Printf("[INFO] Infoblox A record updated with ID: %s", d.Id())
 
+	if err := syncManagedPTR(d, meta); err != nil {
+		return err
+	}
+
 	return resourceInfobloxARecordRead(d, meta)
 }
 
 func resourceInfobloxARecordDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*infoblox.Client)
+	client := meta.(*providerMeta).client
 
 	log.Printf("[DEBUG] Deleting Infoblox A record: %s, %s", d.Get("name").(string), d.Id())
-	_, err := client.GetRecordA(d.Id(), nil)
-	if err != nil {
-		return fmt.Errorf("error finding Infoblox A record: %s", err.Error())
+	if err := deleteManagedPTR(d, meta); err != nil {
+		return err
 	}
 
-	err = client.RecordAObject(d.Id()).Delete(nil)
-	if err != nil {
+	err := wapiDelete(client, d.Id())
+	if err != nil && !isNotFoundError(err) {
 		return fmt.Errorf("error deleting Infoblox A record: %s", err.Error())
 	}
 
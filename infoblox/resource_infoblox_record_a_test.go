@@ -0,0 +1,234 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Records which leave view empty are created in the default_view of the
+// provider.
+func TestMockInfobloxRecordA_defaultView(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	meta := m.meta()
+	meta.defaultView = "internal"
+
+	r := infobloxRecordA()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"address": "10.0.0.10",
+		"name":    "web.example.com",
+	})
+	if err := r.Create(d, meta); err != nil {
+		t.Fatalf("error creating record:a: %s", err)
+	}
+
+	testMockCheckAttributes(t, d, map[string]string{
+		"view": "internal",
+	})
+	if record, _ := m.object(d.Id()); record["view"] != "internal" {
+		t.Fatalf("expected record:a to be created in view internal, got %v", record["view"])
+	}
+}
+
+func TestMockInfobloxRecordA_zone(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	m.add("zone_auth", map[string]interface{}{"fqdn": "example.com", "view": "default"})
+
+	testMockLifecycle(t, m, mockLifecycleTest{
+		Resource:   infobloxRecordA(),
+		ObjectType: "record:a",
+		Create: map[string]interface{}{
+			"address": "10.0.0.10",
+			"name":    "www.eu",
+			"zone":    "example.com",
+		},
+		CreateCheck: map[string]string{
+			"name": "www.eu",
+			"zone": "example.com",
+		},
+	})
+
+	meta := m.meta()
+	r := infobloxRecordA()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"address": "10.0.0.11",
+		"name":    "@",
+		"zone":    "example.com",
+	})
+	if err := r.Create(d, meta); err != nil {
+		t.Fatalf("error creating record:a: %s", err)
+	}
+	if record, _ := m.object(d.Id()); record["name"] != "example.com" {
+		t.Fatalf("expected record:a at the apex of example.com, got %v", record["name"])
+	}
+	testMockCheckAttributes(t, d, map[string]string{
+		"name": "@",
+	})
+
+	for _, config := range []map[string]interface{}{
+		{"address": "10.0.0.12", "name": "www", "zone": "example.org"},
+		{"address": "10.0.0.12", "name": "www.example.org.", "zone": "example.com"},
+		{"address": "10.0.0.12", "name": "@"},
+	} {
+		d := schema.TestResourceDataRaw(t, r.Schema, config)
+		if err := r.Create(d, meta); err == nil {
+			t.Fatalf("expected an error creating record:a %v", config)
+		}
+	}
+	if n := m.count("record:a"); n != 1 {
+		t.Fatalf("expected 1 record:a, found %d", n)
+	}
+}
+
+func TestMockInfobloxRecordA_createPTR(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	meta := m.meta()
+	r := infobloxRecordA()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"address":    "10.0.2.1",
+		"name":       "web.example.com",
+		"ttl":        300,
+		"create_ptr": true,
+	})
+	if err := r.Create(d, meta); err != nil {
+		t.Fatalf("error creating record:a: %s", err)
+	}
+
+	ptr, ok := m.object(d.Get("ptr_ref").(string))
+	if !ok {
+		t.Fatalf("no record:ptr created for record:a %s", d.Id())
+	}
+	if ptr["name"] != "1.2.0.10.in-addr.arpa" || ptr["ptrdname"] != "web.example.com" || ptr["view"] != "default" {
+		t.Fatalf("unexpected record:ptr %v", ptr)
+	}
+
+	// A PTR deleted outside of terraform is created again by the next apply.
+	if err := wapiDelete(m.client(), d.Get("ptr_ref").(string)); err != nil {
+		t.Fatalf("error deleting record:ptr: %s", err)
+	}
+	if err := r.Read(d, meta); err != nil {
+		t.Fatalf("error reading record:a: %s", err)
+	}
+	testMockCheckAttributes(t, d, map[string]string{
+		"create_ptr": "false",
+		"ptr_ref":    "",
+	})
+	d.Set("create_ptr", true)
+	if err := r.Update(d, meta); err != nil {
+		t.Fatalf("error updating record:a: %s", err)
+	}
+	if n := m.count("record:ptr"); n != 1 {
+		t.Fatalf("expected 1 record:ptr after update, found %d", n)
+	}
+
+	d.Set("create_ptr", false)
+	if err := r.Update(d, meta); err != nil {
+		t.Fatalf("error updating record:a: %s", err)
+	}
+	if n := m.count("record:ptr"); n != 0 {
+		t.Fatalf("expected the record:ptr to be deleted with create_ptr off, found %d", n)
+	}
+
+	d.Set("create_ptr", true)
+	if err := r.Update(d, meta); err != nil {
+		t.Fatalf("error updating record:a: %s", err)
+	}
+	if err := r.Delete(d, meta); err != nil {
+		t.Fatalf("error deleting record:a: %s", err)
+	}
+	if n := m.count("record:ptr"); n != 0 {
+		t.Fatalf("expected the record:ptr to be deleted with the record:a, found %d", n)
+	}
+}
+
+func TestMockInfobloxRecordAImport(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	ref := m.add("record:a", map[string]interface{}{
+		"name":     "web.example.com",
+		"ipv4addr": "10.0.0.10",
+		"view":     "default",
+	})
+	m.add("record:a", map[string]interface{}{
+		"name":     "web.example.com",
+		"ipv4addr": "10.0.0.11",
+		"view":     "default",
+	})
+
+	r := infobloxRecordA()
+	d := r.TestResourceData()
+	d.SetId("default/web.example.com/10.0.0.10")
+
+	imported, err := r.Importer.State(d, m.meta())
+	if err != nil {
+		t.Fatalf("error importing A record: %s", err)
+	}
+	if imported[0].Id() != ref {
+		t.Fatalf("expected import to resolve to %s, got %s", ref, imported[0].Id())
+	}
+
+	d = r.TestResourceData()
+	d.SetId("default/web.example.com")
+	if _, err := r.Importer.State(d, m.meta()); err == nil {
+		t.Fatalf("expected an error importing an ambiguous A record")
+	}
+}
+
+// A record created by the deprecated infoblox_record resource can be imported
+// into infoblox_record_a without touching the record on the grid.
+func TestMockInfobloxRecordAImport_legacy(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	meta := m.meta()
+
+	legacy := resourceInfobloxRecord()
+	ld := schema.TestResourceDataRaw(t, legacy.Schema, map[string]interface{}{
+		"name":   "web",
+		"domain": "example.com",
+		"value":  "10.0.0.10",
+		"type":   "A",
+	})
+	if err := legacy.Create(ld, meta); err != nil {
+		t.Fatalf("error creating infoblox_record: %s", err)
+	}
+
+	r := infobloxRecordA()
+	d := r.TestResourceData()
+	d.SetId("A:" + ld.Id())
+
+	imported, err := r.Importer.State(d, meta)
+	if err != nil {
+		t.Fatalf("error importing infoblox_record: %s", err)
+	}
+	d = imported[0]
+	if d.Id() != ld.Id() {
+		t.Fatalf("expected import to resolve to %s, got %s", ld.Id(), d.Id())
+	}
+
+	if err := r.Read(d, meta); err != nil {
+		t.Fatalf("error reading imported A record: %s", err)
+	}
+	testMockCheckAttributes(t, d, map[string]string{
+		"name":    "web.example.com",
+		"address": "10.0.0.10",
+		"ttl":     "3600",
+		"view":    "default",
+	})
+	if n := m.count("record:a"); n != 1 {
+		t.Fatalf("expected the A record to be left in place, found %d", n)
+	}
+
+	d = r.TestResourceData()
+	d.SetId("CNAME:" + ld.Id())
+	if _, err := r.Importer.State(d, meta); err == nil {
+		t.Fatalf("expected an error importing an infoblox_record with the wrong type")
+	}
+}
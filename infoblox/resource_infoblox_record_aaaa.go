@@ -15,18 +15,28 @@ func infobloxRecordAAAA() *schema.Resource {
 		Read:   resourceInfobloxAAAARecordRead,
 		Update: resourceInfobloxAAAARecordUpdate,
 		Delete: resourceInfobloxAAAARecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: importInfobloxRecord("record:aaaa", "ipv6addr"),
+		},
 
 		Schema: map[string]*schema.Schema{
-			// TODO: validate that address is in IPv6 format.
 			"address": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIPv6Address,
 			},
 			"name": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRecordName,
+			},
+			"zone": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateFQDN,
 			},
 			"comment": &schema.Schema{
 				Type:     schema.TypeString,
@@ -34,23 +44,40 @@ func infobloxRecordAAAA() *schema.Resource {
 				Default:  "",
 			},
 			"ttl": &schema.Schema{
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateTTL,
 			},
 			"view": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
+			},
+			"create_ptr": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
+			"ptr_ref": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"extensible_attributes": extAttrsSchema(),
 		},
 	}
 }
 
 func resourceInfobloxAAAARecordCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*infoblox.Client)
+	setDefaultView(d, meta)
+	client := meta.(*providerMeta).client
+
+	if err := validateRecordZone(d, meta); err != nil {
+		return err
+	}
 
 	record := url.Values{}
 	record.Add("ipv6addr", d.Get("address").(string))
-	record.Add("name", d.Get("name").(string))
+	record.Add("name", recordFQDN(d))
 	populateSharedAttributes(d, &record)
 
 	log.Printf("[DEBUG] Creating Infoblox AAAA record with configuration: %#v", record)
@@ -58,7 +85,11 @@ func resourceInfobloxAAAARecordCreate(d *schema.ResourceData, meta interface{})
 	opts := &infoblox.Options{
 		ReturnFields: []string{"ipv6addr", "name", "comment", "ttl", "view"},
 	}
-	recordID, err := client.RecordAAAA().Create(record, opts, nil)
+	extAttrs, err := extAttrsFromResourceData(d, meta)
+	if err != nil {
+		return err
+	}
+	recordID, err := client.RecordAAAA().Create(record, opts, extAttrs)
 
 	if err != nil {
 		return fmt.Errorf("error creating infoblox AAAA record: %s", err.Error())
@@ -67,11 +98,15 @@ func resourceInfobloxAAAARecordCreate(d *schema.ResourceData, meta interface{})
 	d.SetId(recordID)
 	log.Printf("[INFO] Infoblox AAAA record created with ID: %s", d.Id())
 
+	if err := syncManagedPTR(d, meta); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func resourceInfobloxAAAARecordRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*infoblox.Client)
+	client := meta.(*providerMeta).client
 
 	opts := &infoblox.Options{
 		ReturnFields: []string{"ipv6addr", "name", "comment", "ttl", "view"},
@@ -82,7 +117,7 @@ func resourceInfobloxAAAARecordRead(d *schema.ResourceData, meta interface{}) er
 	}
 
 	d.Set("address", record.Ipv6Addr)
-	d.Set("name", record.Name)
+	setRecordName(d, record.Name)
 
 	if &record.Comment != nil {
 		d.Set("comment", record.Comment)
@@ -94,11 +129,18 @@ func resourceInfobloxAAAARecordRead(d *schema.ResourceData, meta interface{}) er
 		d.Set("view", record.View)
 	}
 
+	if err := readExtAttrs(d, meta, d.Id()); err != nil {
+		return handleReadError(d, "AAAA", err)
+	}
+	if err := readManagedPTR(d, meta); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func resourceInfobloxAAAARecordUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*infoblox.Client)
+	client := meta.(*providerMeta).client
 
 	opts := &infoblox.Options{
 		ReturnFields: []string{"ipv6addr", "name", "comment", "ttl", "view"},
@@ -110,12 +152,16 @@ func resourceInfobloxAAAARecordUpdate(d *schema.ResourceData, meta interface{})
 
 	record := url.Values{}
 	record.Add("ipv6addr", d.Get("address").(string))
-	record.Add("name", d.Get("name").(string))
+	record.Add("name", recordFQDN(d))
 	populateSharedAttributes(d, &record)
 
 	log.Printf("[DEBUG] Updating Infoblox AAAA record with configuration: %#v", record)
 
-	recordID, err := client.RecordAAAAObject(d.Id()).Update(record, opts, nil)
+	extAttrs, err := extAttrsFromResourceData(d, meta)
+	if err != nil {
+		return err
+	}
+	recordID, err := client.RecordAAAAObject(d.Id()).Update(record, opts, extAttrs)
 	if err != nil {
 		return fmt.Errorf("error updating Infoblox AAAA record: %s", err.Error())
 	}
@@ -123,20 +169,23 @@ func resourceInfobloxAAAARecordUpdate(d *schema.ResourceData, meta interface{})
 	d.SetId(recordID)
 	log.Printf("[INFO] Infoblox AAAA record updated with ID: %s", d.Id())
 
+	if err := syncManagedPTR(d, meta); err != nil {
+		return err
+	}
+
 	return resourceInfobloxAAAARecordRead(d, meta)
 }
 
 func resourceInfobloxAAAARecordDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*infoblox.Client)
+	client := meta.(*providerMeta).client
 
 	log.Printf("[DEBUG] Deleting Infoblox AAAA record: %s, %s", d.Get("name").(string), d.Id())
-	_, err := client.GetRecordAAAA(d.Id(), nil)
-	if err != nil {
-		return fmt.Errorf("error finding Infoblox AAAA record: %s", err.Error())
+	if err := deleteManagedPTR(d, meta); err != nil {
+		return err
 	}
 
-	err = client.RecordAAAAObject(d.Id()).Delete(nil)
-	if err != nil {
+	err := wapiDelete(client, d.Id())
+	if err != nil && !isNotFoundError(err) {
 		return fmt.Errorf("error deleting Infoblox AAAA record: %s", err.Error())
 	}
 
@@ -0,0 +1,27 @@
+package infoblox
+
+import (
+	"testing"
+)
+
+func TestMockInfobloxRecordAAAA_createPTR(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	testMockLifecycle(t, m, mockLifecycleTest{
+		Resource:   infobloxRecordAAAA(),
+		ObjectType: "record:aaaa",
+		Create: map[string]interface{}{
+			"address":    "2001:db8::10",
+			"name":       "web.example.com",
+			"create_ptr": true,
+		},
+		CreateCheck: map[string]string{
+			"create_ptr": "true",
+		},
+	})
+
+	if n := m.count("record:ptr"); n != 0 {
+		t.Fatalf("expected the record:ptr to be deleted with the record:aaaa, found %d", n)
+	}
+}
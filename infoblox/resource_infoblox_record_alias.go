@@ -0,0 +1,165 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func infobloxRecordAlias() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInfobloxAliasRecordCreate,
+		Read:   resourceInfobloxAliasRecordRead,
+		Update: resourceInfobloxAliasRecordUpdate,
+		Delete: resourceInfobloxAliasRecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: importInfobloxRecord("record:alias", "target_type"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateHostName,
+			},
+			"zone": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateFQDN,
+			},
+			"target_name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateFQDN,
+			},
+			"target_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAliasTargetType,
+			},
+			"comment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			"ttl": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateTTL,
+			},
+			"view": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"extensible_attributes": extAttrsSchema(),
+		},
+	}
+}
+
+// aliasRecordReturnFields lists the fields we read back for alias records.
+var aliasRecordReturnFields = append([]string{"target_name", "target_type"}, sharedRecordReturnFields...)
+
+// aliasRecordObjectFromAttributes builds the body of a record:alias create or
+// update request from the attributes as set by terraform.
+func aliasRecordObjectFromAttributes(d *schema.ResourceData, meta interface{}) (map[string]interface{}, error) {
+	name := recordFQDN(d)
+	target := d.Get("target_name").(string)
+
+	if relativeRecordName(target, name) == "@" {
+		return nil, fmt.Errorf("alias record %s must not point at itself", name)
+	}
+
+	record := map[string]interface{}{
+		"name":        name,
+		"target_name": target,
+		"target_type": d.Get("target_type").(string),
+	}
+	populateSharedFields(d, record)
+
+	extAttrs, err := extAttrsFromResourceData(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	extAttrs.addTo(record)
+
+	return record, nil
+}
+
+func resourceInfobloxAliasRecordCreate(d *schema.ResourceData, meta interface{}) error {
+	setDefaultView(d, meta)
+	client := meta.(*providerMeta).client
+
+	if err := validateRecordZone(d, meta); err != nil {
+		return err
+	}
+
+	record, err := aliasRecordObjectFromAttributes(d, meta)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating Infoblox alias record with configuration: %#v", record)
+
+	recordID, err := wapiCreate(client, "record:alias", record)
+	if err != nil {
+		return fmt.Errorf("error creating Infoblox alias record: %s", err.Error())
+	}
+
+	d.SetId(recordID)
+	log.Printf("[INFO] Infoblox alias record created with ID: %s", d.Id())
+
+	return resourceInfobloxAliasRecordRead(d, meta)
+}
+
+func resourceInfobloxAliasRecordRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	record, err := wapiGet(client, d.Id(), aliasRecordReturnFields)
+	if err != nil {
+		return handleReadError(d, "alias", err)
+	}
+
+	readSharedFields(d, meta, record)
+	d.Set("target_name", record["target_name"])
+	d.Set("target_type", record["target_type"])
+
+	return nil
+}
+
+func resourceInfobloxAliasRecordUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	record, err := aliasRecordObjectFromAttributes(d, meta)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Infoblox alias record with configuration: %#v", record)
+
+	recordID, err := wapiUpdate(client, d.Id(), record)
+	if err != nil {
+		return fmt.Errorf("error updating Infoblox alias record: %s", err.Error())
+	}
+
+	d.SetId(recordID)
+	log.Printf("[INFO] Infoblox alias record updated with ID: %s", d.Id())
+
+	return resourceInfobloxAliasRecordRead(d, meta)
+}
+
+func resourceInfobloxAliasRecordDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	log.Printf("[DEBUG] Deleting Infoblox alias record: %s, %s", d.Get("name").(string), d.Id())
+
+	err := wapiDelete(client, d.Id())
+	if err != nil && !isNotFoundError(err) {
+		return fmt.Errorf("error deleting Infoblox alias record: %s", err.Error())
+	}
+
+	return nil
+}
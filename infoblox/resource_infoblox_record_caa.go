@@ -0,0 +1,197 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func infobloxRecordCAA() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInfobloxCAARecordCreate,
+		Read:   resourceInfobloxCAARecordRead,
+		Update: resourceInfobloxCAARecordUpdate,
+		Delete: resourceInfobloxCAARecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: importInfobloxRecord("record:caa", "ca_value"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateHostName,
+			},
+			"zone": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateFQDN,
+			},
+			"ca_flag": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validateCAAFlag,
+			},
+			"ca_tag": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateCAATag,
+			},
+			"ca_value": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateCAAValueText,
+			},
+			"comment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			"ttl": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateTTL,
+			},
+			"view": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"extensible_attributes": extAttrsSchema(),
+		},
+	}
+}
+
+// caaRecordReturnFields lists the fields we read back for CAA records.
+var caaRecordReturnFields = append([]string{"ca_flag", "ca_tag", "ca_value"}, sharedRecordReturnFields...)
+
+// validateCAAValue checks the value of a CAA record against its tag: iodef
+// records report to a mailto:, http: or https: URL, while issue and
+// issuewild records name a certificate authority, or ";" to forbid issuance.
+func validateCAAValue(tag, value string) error {
+	switch strings.ToLower(tag) {
+	case "iodef":
+		for _, scheme := range []string{"mailto:", "http://", "https://"} {
+			if strings.HasPrefix(value, scheme) {
+				return nil
+			}
+		}
+		return fmt.Errorf("the ca_value of an iodef CAA record must be a mailto:, http:// or https:// URL, got %q", value)
+	case "issue", "issuewild":
+		domain := strings.TrimSpace(strings.SplitN(value, ";", 2)[0])
+		if domain != "" && !isFQDN(domain) {
+			return fmt.Errorf("the ca_value of an %s CAA record must start with the domain of a certificate authority, got %q",
+				tag, value)
+		}
+	}
+	return nil
+}
+
+// caaRecordObjectFromAttributes builds the body of a record:caa create or
+// update request from the attributes as set by terraform.
+func caaRecordObjectFromAttributes(d *schema.ResourceData, meta interface{}) (map[string]interface{}, error) {
+	tag := d.Get("ca_tag").(string)
+	value := d.Get("ca_value").(string)
+	if err := validateCAAValue(tag, value); err != nil {
+		return nil, err
+	}
+
+	record := map[string]interface{}{
+		"name":     recordFQDN(d),
+		"ca_flag":  d.Get("ca_flag").(int),
+		"ca_tag":   tag,
+		"ca_value": value,
+	}
+	populateSharedFields(d, record)
+
+	extAttrs, err := extAttrsFromResourceData(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	extAttrs.addTo(record)
+
+	return record, nil
+}
+
+func resourceInfobloxCAARecordCreate(d *schema.ResourceData, meta interface{}) error {
+	setDefaultView(d, meta)
+	client := meta.(*providerMeta).client
+
+	if err := validateRecordZone(d, meta); err != nil {
+		return err
+	}
+
+	record, err := caaRecordObjectFromAttributes(d, meta)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating Infoblox CAA record with configuration: %#v", record)
+
+	recordID, err := wapiCreate(client, "record:caa", record)
+	if err != nil {
+		return fmt.Errorf("error creating Infoblox CAA record: %s", err.Error())
+	}
+
+	d.SetId(recordID)
+	log.Printf("[INFO] Infoblox CAA record created with ID: %s", d.Id())
+
+	return resourceInfobloxCAARecordRead(d, meta)
+}
+
+func resourceInfobloxCAARecordRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	record, err := wapiGet(client, d.Id(), caaRecordReturnFields)
+	if err != nil {
+		return handleReadError(d, "CAA", err)
+	}
+
+	readSharedFields(d, meta, record)
+	d.Set("ca_tag", record["ca_tag"])
+	d.Set("ca_value", record["ca_value"])
+	if flag, ok := wapiInt(record["ca_flag"]); ok {
+		d.Set("ca_flag", flag)
+	}
+
+	return nil
+}
+
+func resourceInfobloxCAARecordUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	record, err := caaRecordObjectFromAttributes(d, meta)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Infoblox CAA record with configuration: %#v", record)
+
+	recordID, err := wapiUpdate(client, d.Id(), record)
+	if err != nil {
+		return fmt.Errorf("error updating Infoblox CAA record: %s", err.Error())
+	}
+
+	d.SetId(recordID)
+	log.Printf("[INFO] Infoblox CAA record updated with ID: %s", d.Id())
+
+	return resourceInfobloxCAARecordRead(d, meta)
+}
+
+func resourceInfobloxCAARecordDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	log.Printf("[DEBUG] Deleting Infoblox CAA record: %s, %s", d.Get("name").(string), d.Id())
+
+	err := wapiDelete(client, d.Id())
+	if err != nil && !isNotFoundError(err) {
+		return fmt.Errorf("error deleting Infoblox CAA record: %s", err.Error())
+	}
+
+	return nil
+}
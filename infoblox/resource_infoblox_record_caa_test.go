@@ -0,0 +1,55 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestMockInfobloxRecordCAA_invalidValue(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	r := infobloxRecordCAA()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":     "example.com",
+		"ca_tag":   "iodef",
+		"ca_value": "security@example.com",
+	})
+	if err := r.Create(d, m.meta()); err == nil {
+		t.Fatal("expected an error creating an iodef CAA record without a URL")
+	}
+	if n := m.count("record:caa"); n != 0 {
+		t.Fatalf("expected no CAA record to be created, found %d", n)
+	}
+}
+
+func TestMockInfobloxRecordCAAImport(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	ref := m.add("record:caa", map[string]interface{}{
+		"name":     "example.com",
+		"ca_tag":   "issue",
+		"ca_value": "letsencrypt.org",
+		"view":     "default",
+	})
+	m.add("record:caa", map[string]interface{}{
+		"name":     "example.com",
+		"ca_tag":   "issue",
+		"ca_value": "pki.goog",
+		"view":     "default",
+	})
+
+	r := infobloxRecordCAA()
+	d := r.TestResourceData()
+	d.SetId("default/example.com/letsencrypt.org")
+
+	imported, err := r.Importer.State(d, m.meta())
+	if err != nil {
+		t.Fatalf("error importing record:caa: %s", err)
+	}
+	if imported[0].Id() != ref {
+		t.Fatalf("expected import to resolve to %s, got %s", ref, imported[0].Id())
+	}
+}
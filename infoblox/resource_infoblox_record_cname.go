@@ -15,17 +15,28 @@ func infobloxRecordCNAME() *schema.Resource {
 		Read:   resourceInfobloxCNAMERecordRead,
 		Update: resourceInfobloxCNAMERecordUpdate,
 		Delete: resourceInfobloxCNAMERecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: importInfobloxRecord("record:cname", "canonical"),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"canonical": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateFQDN,
 			},
 			"name": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRecordName,
+			},
+			"zone": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateFQDN,
 			},
 			"comment": &schema.Schema{
 				Type:     schema.TypeString,
@@ -33,23 +44,31 @@ func infobloxRecordCNAME() *schema.Resource {
 				Default:  "",
 			},
 			"ttl": &schema.Schema{
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateTTL,
 			},
 			"view": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
+			"extensible_attributes": extAttrsSchema(),
 		},
 	}
 }
 
 func resourceInfobloxCNAMERecordCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*infoblox.Client)
+	setDefaultView(d, meta)
+	client := meta.(*providerMeta).client
+
+	if err := validateRecordZone(d, meta); err != nil {
+		return err
+	}
 
 	record := url.Values{}
 	record.Add("canonical", d.Get("canonical").(string))
-	record.Add("name", d.Get("name").(string))
+	record.Add("name", recordFQDN(d))
 	populateSharedAttributes(d, &record)
 
 	log.Printf("[DEBUG] Creating Infoblox CNAME record with configuration: %#v", record)
@@ -57,7 +76,11 @@ func resourceInfobloxCNAMERecordCreate(d *schema.ResourceData, meta interface{})
 	opts := &infoblox.Options{
 		ReturnFields: []string{"canonical", "name", "comment", "ttl", "view"},
 	}
-	recordID, err := client.RecordCname().Create(record, opts, nil)
+	extAttrs, err := extAttrsFromResourceData(d, meta)
+	if err != nil {
+		return err
+	}
+	recordID, err := client.RecordCname().Create(record, opts, extAttrs)
 	if err != nil {
 		return fmt.Errorf("error creating infoblox CNAME record: %s", err.Error())
 	}
@@ -69,7 +92,13 @@ func resourceInfobloxCNAMERecordCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceInfobloxCNAMERecordRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*infoblox.Client)
+	return readCNAMERecord(d, meta, setRecordName)
+}
+
+// readCNAMERecord reads the CNAME record of a resource or data source, which
+// set the name of the record with setName.
+func readCNAMERecord(d *schema.ResourceData, meta interface{}, setName func(*schema.ResourceData, string)) error {
+	client := meta.(*providerMeta).client
 
 	opts := &infoblox.Options{
 		ReturnFields: []string{"canonical", "name", "comment", "ttl", "view"},
@@ -80,7 +109,7 @@ func resourceInfobloxCNAMERecordRead(d *schema.ResourceData, meta interface{}) e
 	}
 
 	d.Set("canonical", record.Canonical)
-	d.Set("name", record.Name)
+	setName(d, record.Name)
 
 	if &record.Comment != nil {
 		d.Set("comment", record.Comment)
@@ -92,11 +121,15 @@ func resourceInfobloxCNAMERecordRead(d *schema.ResourceData, meta interface{}) e
 		d.Set("view", record.View)
 	}
 
+	if err := readExtAttrs(d, meta, d.Id()); err != nil {
+		return handleReadError(d, "CNAME", err)
+	}
+
 	return nil
 }
 
 func resourceInfobloxCNAMERecordUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*infoblox.Client)
+	client := meta.(*providerMeta).client
 
 	opts := &infoblox.Options{
 		ReturnFields: []string{"canonical", "name", "comment", "ttl", "view"},
@@ -108,12 +141,16 @@ func resourceInfobloxCNAMERecordUpdate(d *schema.ResourceData, meta interface{})
 
 	record := url.Values{}
 	record.Add("canonical", d.Get("canonical").(string))
-	record.Add("name", d.Get("name").(string))
+	record.Add("name", recordFQDN(d))
 	populateSharedAttributes(d, &record)
 
 	log.Printf("[DEBUG] Updating Infoblox CNAME record with configuration: %#v", record)
 
-	recordID, err := client.RecordCnameObject(d.Id()).Update(record, opts, nil)
+	extAttrs, err := extAttrsFromResourceData(d, meta)
+	if err != nil {
+		return err
+	}
+	recordID, err := client.RecordCnameObject(d.Id()).Update(record, opts, extAttrs)
 	if err != nil {
 		return fmt.Errorf("error updating Infoblox CNAME record: %s", err.Error())
 	}
@@ -125,16 +162,11 @@ func resourceInfobloxCNAMERecordUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceInfobloxCNAMERecordDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*infoblox.Client)
+	client := meta.(*providerMeta).client
 
 	log.Printf("[DEBUG] Deleting Infoblox CNAME record: %s, %s", d.Get("name").(string), d.Id())
-	_, err := client.GetRecordCname(d.Id(), nil)
-	if err != nil {
-		return fmt.Errorf("error finding Infoblox CNAME record: %s", err.Error())
-	}
-
-	err = client.RecordCnameObject(d.Id()).Delete(nil)
-	if err != nil {
+	err := wapiDelete(client, d.Id())
+	if err != nil && !isNotFoundError(err) {
 		return fmt.Errorf("error deleting Infoblox CNAME record: %s", err.Error())
 	}
 
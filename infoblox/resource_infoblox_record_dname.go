@@ -0,0 +1,161 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func infobloxRecordDNAME() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInfobloxDNAMERecordCreate,
+		Read:   resourceInfobloxDNAMERecordRead,
+		Update: resourceInfobloxDNAMERecordUpdate,
+		Delete: resourceInfobloxDNAMERecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: importInfobloxRecord("record:dname", "target"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateHostName,
+			},
+			"zone": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateFQDN,
+			},
+			"target": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateFQDN,
+			},
+			"comment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			"ttl": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateTTL,
+			},
+			"view": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"extensible_attributes": extAttrsSchema(),
+		},
+	}
+}
+
+// dnameRecordReturnFields lists the fields we read back for DNAME records.
+var dnameRecordReturnFields = append([]string{"target"}, sharedRecordReturnFields...)
+
+// dnameRecordObjectFromAttributes builds the body of a record:dname create or
+// update request from the attributes as set by terraform.
+func dnameRecordObjectFromAttributes(d *schema.ResourceData, meta interface{}) (map[string]interface{}, error) {
+	name := recordFQDN(d)
+	target := d.Get("target").(string)
+
+	// A DNAME rewrites every name below its own, so pointing it inside
+	// itself would make resolution loop.
+	if absolute := strings.TrimSuffix(target, "."); relativeRecordName(absolute, name) != absolute {
+		return nil, fmt.Errorf("the target %s of DNAME record %s must not be inside it", target, name)
+	}
+
+	record := map[string]interface{}{
+		"name":   name,
+		"target": target,
+	}
+	populateSharedFields(d, record)
+
+	extAttrs, err := extAttrsFromResourceData(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	extAttrs.addTo(record)
+
+	return record, nil
+}
+
+func resourceInfobloxDNAMERecordCreate(d *schema.ResourceData, meta interface{}) error {
+	setDefaultView(d, meta)
+	client := meta.(*providerMeta).client
+
+	if err := validateRecordZone(d, meta); err != nil {
+		return err
+	}
+
+	record, err := dnameRecordObjectFromAttributes(d, meta)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating Infoblox DNAME record with configuration: %#v", record)
+
+	recordID, err := wapiCreate(client, "record:dname", record)
+	if err != nil {
+		return fmt.Errorf("error creating Infoblox DNAME record: %s", err.Error())
+	}
+
+	d.SetId(recordID)
+	log.Printf("[INFO] Infoblox DNAME record created with ID: %s", d.Id())
+
+	return resourceInfobloxDNAMERecordRead(d, meta)
+}
+
+func resourceInfobloxDNAMERecordRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	record, err := wapiGet(client, d.Id(), dnameRecordReturnFields)
+	if err != nil {
+		return handleReadError(d, "DNAME", err)
+	}
+
+	readSharedFields(d, meta, record)
+	d.Set("target", record["target"])
+
+	return nil
+}
+
+func resourceInfobloxDNAMERecordUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	record, err := dnameRecordObjectFromAttributes(d, meta)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Infoblox DNAME record with configuration: %#v", record)
+
+	recordID, err := wapiUpdate(client, d.Id(), record)
+	if err != nil {
+		return fmt.Errorf("error updating Infoblox DNAME record: %s", err.Error())
+	}
+
+	d.SetId(recordID)
+	log.Printf("[INFO] Infoblox DNAME record updated with ID: %s", d.Id())
+
+	return resourceInfobloxDNAMERecordRead(d, meta)
+}
+
+func resourceInfobloxDNAMERecordDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	log.Printf("[DEBUG] Deleting Infoblox DNAME record: %s, %s", d.Get("name").(string), d.Id())
+
+	err := wapiDelete(client, d.Id())
+	if err != nil && !isNotFoundError(err) {
+		return fmt.Errorf("error deleting Infoblox DNAME record: %s", err.Error())
+	}
+
+	return nil
+}
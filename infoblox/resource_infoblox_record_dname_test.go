@@ -0,0 +1,21 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestMockInfobloxRecordDNAME_loop(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	r := infobloxRecordDNAME()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":   "old.example.com",
+		"target": "new.old.example.com.",
+	})
+	if err := r.Create(d, m.meta()); err == nil {
+		t.Fatal("expected an error creating a DNAME record pointing inside itself")
+	}
+}
@@ -13,34 +13,86 @@ import (
 func hostIPv4Schema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"address": {
-			Type:     schema.TypeString,
-			Required: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validateIPv4Address,
+		},
+		"network": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validateCIDR,
+		},
+		"range": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validateIPRange,
 		},
 		"configure_for_dhcp": {
 			Type:     schema.TypeBool,
 			Optional: true,
 		},
 		"mac": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateMACAddress,
+		},
+		"option": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     &schema.Resource{Schema: dhcpOptionSchema()},
+		},
+		"bootfile": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"nextserver": {
 			Type:     schema.TypeString,
 			Optional: true,
 		},
+		"use_for_ea_inheritance": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
 	}
 }
 
-// hostIPv6Schema represents the schema for the host IPv4 sub-resource
+// hostIPv6Schema represents the schema for the host IPv6 sub-resource
 func hostIPv6Schema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"address": {
-			Type:     schema.TypeString,
-			Required: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validateIPv6Address,
+		},
+		"network": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validateCIDR,
+		},
+		"range": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validateIPRange,
 		},
 		"configure_for_dhcp": {
 			Type:     schema.TypeBool,
 			Optional: true,
 		},
 		"mac": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateMACAddress,
+		},
+		"duid": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateDUID,
 		},
 	}
 }
@@ -51,12 +103,22 @@ func infobloxRecordHost() *schema.Resource {
 		Read:   resourceInfobloxHostRecordRead,
 		Update: resourceInfobloxHostRecordUpdate,
 		Delete: resourceInfobloxHostRecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: importInfobloxRecord("record:host", "ipv4addr"),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateHostName,
+			},
+			"zone": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateFQDN,
 			},
 			"ipv4addr": &schema.Schema{
 				Type:     schema.TypeList,
@@ -68,6 +130,12 @@ func infobloxRecordHost() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Resource{Schema: hostIPv6Schema()},
 			},
+			"aliases": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: validateFQDN},
+				Set:      schema.HashString,
+			},
 			"configure_for_dns": &schema.Schema{
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -79,26 +147,98 @@ func infobloxRecordHost() *schema.Resource {
 				Default:  "",
 			},
 			"ttl": &schema.Schema{
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateTTL,
 			},
 			"view": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
-				Default:  "default",
+				Computed: true,
 			},
+			"extensible_attributes": extAttrsSchema(),
 		},
 	}
 }
 
-func ipv4sFromList(ipv4s []interface{}) []infoblox.HostIpv4Addr {
-	var result []infoblox.HostIpv4Addr
+// hostIPv4Addr adds the DHCP fields infoblox.HostIpv4Addr lacks. WAPI
+// ignores options, bootfile and nextserver unless their use_ flag is set.
+type hostIPv4Addr struct {
+	infoblox.HostIpv4Addr
+	Options             []map[string]interface{} `json:"options"`
+	UseOptions          bool                     `json:"use_options"`
+	Bootfile            string                   `json:"bootfile,omitempty"`
+	UseBootfile         bool                     `json:"use_bootfile"`
+	Nextserver          string                   `json:"nextserver,omitempty"`
+	UseNextserver       bool                     `json:"use_nextserver"`
+	UseForEAInheritance bool                     `json:"use_for_ea_inheritance"`
+}
+
+// hostIPv6Addr adds the duid field, which infoblox.HostIpv6Addr lacks.
+type hostIPv6Addr struct {
+	infoblox.HostIpv6Addr
+	Duid string `json:"duid,omitempty"`
+}
+
+// validateHostAddresses checks that every ipv4addr and ipv6addr block of a
+// host either sets its address or the network or range to allocate it from.
+func validateHostAddresses(d *schema.ResourceData) error {
+	for _, family := range []string{"ipv4addr", "ipv6addr"} {
+		for _, v := range d.Get(family).([]interface{}) {
+			ipMap := v.(map[string]interface{})
+			address := ipMap["address"].(string)
+			network := ipMap["network"].(string)
+			ipRange := ipMap["range"].(string)
+
+			if network != "" && ipRange != "" {
+				return fmt.Errorf("only one of 'network' and 'range' may be set in an %s block", family)
+			}
+			if address == "" && network == "" && ipRange == "" {
+				return fmt.Errorf("one of 'address', 'network' or 'range' must be set in an %s block", family)
+			}
+			// Once allocated, the address is kept in the state next to
+			// the network or range it was allocated from.
+			if d.Id() == "" && address != "" && (network != "" || ipRange != "") {
+				return fmt.Errorf("'address' cannot be set together with 'network' or 'range' in an %s block", family)
+			}
+		}
+	}
+	return nil
+}
+
+// hostAddressFromMap returns the address of an ipv4addr or ipv6addr block.
+// Blocks which have not been allocated an address yet get the WAPI function
+// allocating the next available address of their network or range, so the
+// grid reserves it in the same request that creates the host.
+func hostAddressFromMap(ipMap map[string]interface{}, meta interface{}) (string, error) {
+	if address := ipMap["address"].(string); address != "" {
+		return address, nil
+	}
+
+	networkView := meta.(*providerMeta).defaultNetworkView
+	if network := ipMap["network"].(string); network != "" {
+		return fmt.Sprintf("func:nextavailableip:%s,%s", network, networkView), nil
+	}
+
+	ipRange, err := resolveIPRange(meta.(*providerMeta).client, ipMap["range"].(string))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("func:nextavailableip:%s,%s", ipRange, networkView), nil
+}
+
+func ipv4sFromList(ipv4s []interface{}, meta interface{}) ([]hostIPv4Addr, error) {
+	var result []hostIPv4Addr
 
 	for _, v := range ipv4s {
 		ipMap := v.(map[string]interface{})
-		i := infoblox.HostIpv4Addr{}
+		i := hostIPv4Addr{}
 
-		i.Ipv4Addr = ipMap["address"].(string)
+		address, err := hostAddressFromMap(ipMap, meta)
+		if err != nil {
+			return nil, err
+		}
+		i.Ipv4Addr = address
 
 		if val, ok := ipMap["configure_for_dhcp"]; ok {
 			i.ConfigureForDHCP = val.(bool)
@@ -106,20 +246,39 @@ func ipv4sFromList(ipv4s []interface{}) []infoblox.HostIpv4Addr {
 		if val, ok := ipMap["mac"]; ok {
 			i.MAC = val.(string)
 		}
+		if val, ok := ipMap["option"]; ok {
+			i.Options = dhcpOptionsFromList(val.([]interface{}))
+			i.UseOptions = len(i.Options) > 0
+		}
+		if val, ok := ipMap["bootfile"]; ok {
+			i.Bootfile = val.(string)
+			i.UseBootfile = i.Bootfile != ""
+		}
+		if val, ok := ipMap["nextserver"]; ok {
+			i.Nextserver = val.(string)
+			i.UseNextserver = i.Nextserver != ""
+		}
+		if val, ok := ipMap["use_for_ea_inheritance"]; ok {
+			i.UseForEAInheritance = val.(bool)
+		}
 
 		result = append(result, i)
 	}
-	return result
+	return result, nil
 }
 
-func ipv6sFromList(ipv6s []interface{}) []infoblox.HostIpv6Addr {
-	var result []infoblox.HostIpv6Addr
+func ipv6sFromList(ipv6s []interface{}, meta interface{}) ([]hostIPv6Addr, error) {
+	var result []hostIPv6Addr
 
 	for _, v := range ipv6s {
 		ipMap := v.(map[string]interface{})
-		i := infoblox.HostIpv6Addr{}
+		i := hostIPv6Addr{}
 
-		i.Ipv6Addr = ipMap["address"].(string)
+		address, err := hostAddressFromMap(ipMap, meta)
+		if err != nil {
+			return nil, err
+		}
+		i.Ipv6Addr = address
 
 		if val, ok := ipMap["configure_for_dhcp"]; ok {
 			i.ConfigureForDHCP = val.(bool)
@@ -127,17 +286,29 @@ func ipv6sFromList(ipv6s []interface{}) []infoblox.HostIpv6Addr {
 		if val, ok := ipMap["mac"]; ok {
 			i.MAC = val.(string)
 		}
+		if val, ok := ipMap["duid"]; ok {
+			i.Duid = val.(string)
+		}
 		result = append(result, i)
 	}
-	return result
+	return result, nil
 }
 
-func hostObjectFromAttributes(d *schema.ResourceData) infoblox.RecordHostObject {
-	hostObject := infoblox.RecordHostObject{}
+// hostRecordBody adds the fields infoblox.RecordHostObject lacks to the body
+// of host record create and update requests. Its Ipv4Addrs and Ipv6Addrs
+// shadow the ones of the embedded object when encoded.
+type hostRecordBody struct {
+	infoblox.RecordHostObject
+	Aliases   []string       `json:"aliases"`
+	Ipv4Addrs []hostIPv4Addr `json:"ipv4addrs"`
+	Ipv6Addrs []hostIPv6Addr `json:"ipv6addrs"`
+	extAttrFields
+}
 
-	if attr, ok := d.GetOk("name"); ok {
-		hostObject.Name = attr.(string)
-	}
+func hostObjectFromAttributes(d *schema.ResourceData, meta interface{}) (hostRecordBody, error) {
+	hostObject := hostRecordBody{}
+
+	hostObject.Name = recordFQDN(d)
 	if attr, ok := d.GetOk("configure_for_dns"); ok {
 		hostObject.ConfigureForDNS = attr.(bool)
 	}
@@ -150,21 +321,160 @@ func hostObjectFromAttributes(d *schema.ResourceData) infoblox.RecordHostObject
 	if attr, ok := d.GetOk("view"); ok {
 		hostObject.View = attr.(string)
 	}
+
+	// Always send both lists so removing the last address of a family
+	// removes it from the host.
+	hostObject.Ipv4Addrs = []hostIPv4Addr{}
+	hostObject.Ipv6Addrs = []hostIPv6Addr{}
+
+	var err error
 	if attr, ok := d.GetOk("ipv4addr"); ok {
-		hostObject.Ipv4Addrs = ipv4sFromList(attr.([]interface{}))
+		hostObject.Ipv4Addrs, err = ipv4sFromList(attr.([]interface{}), meta)
+		if err != nil {
+			return hostObject, err
+		}
 	}
 	if attr, ok := d.GetOk("ipv6addr"); ok {
-		hostObject.Ipv6Addrs = ipv6sFromList(attr.([]interface{}))
+		hostObject.Ipv6Addrs, err = ipv6sFromList(attr.([]interface{}), meta)
+		if err != nil {
+			return hostObject, err
+		}
+	}
+
+	// Always send the aliases so removing the last one clears them.
+	hostObject.Aliases = []string{}
+	for _, alias := range d.Get("aliases").(*schema.Set).List() {
+		hostObject.Aliases = append(hostObject.Aliases, alias.(string))
 	}
 
-	return hostObject
+	extAttrs, err := extAttrsFromResourceData(d, meta)
+	if err != nil {
+		return hostObject, err
+	}
+	hostObject.extAttrFields = extAttrs
+
+	return hostObject, nil
+}
+
+// hostReturnFields lists the fields we read back for host records.
+var hostReturnFields = []string{
+	"name", "ipv4addrs", "ipv6addrs", "aliases", "configure_for_dns", "comment", "ttl", "view", "extattrs",
+}
+
+// hostIPv4AddrReturnFields and hostIPv6AddrReturnFields list the fields of
+// the address sub-objects which WAPI does not return as part of the host.
+var (
+	hostIPv4AddrReturnFields = []string{
+		"ipv4addr", "configure_for_dhcp", "mac", "options", "use_options",
+		"bootfile", "use_bootfile", "nextserver", "use_nextserver", "use_for_ea_inheritance",
+	}
+	hostIPv6AddrReturnFields = []string{"ipv6addr", "configure_for_dhcp", "mac", "duid"}
+)
+
+// hostAddressObjects returns the given ipv4addrs or ipv6addrs of a host,
+// fetching each address by its reference to get fields which are not
+// returned by default.
+func hostAddressObjects(client *infoblox.Client, addresses interface{}, returnFields []string) ([]map[string]interface{}, error) {
+	var result []map[string]interface{}
+
+	list, _ := addresses.([]interface{})
+	for _, v := range list {
+		address, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if ref, ok := address["_ref"].(string); ok {
+			var err error
+			address, err = wapiGet(client, ref, returnFields)
+			if err != nil {
+				return nil, err
+			}
+		}
+		result = append(result, address)
+	}
+	return result, nil
+}
+
+// hostAllocationFromState returns the network and range the i-th address
+// block of current was allocated from. WAPI does not record them, so they are
+// carried over from the state.
+func hostAllocationFromState(current []interface{}, i int) (interface{}, interface{}) {
+	if i >= len(current) {
+		return "", ""
+	}
+	ipMap, ok := current[i].(map[string]interface{})
+	if !ok {
+		return "", ""
+	}
+	return ipMap["network"], ipMap["range"]
+}
+
+// flattenHostIPv4Addrs flattens the IPv4 addresses of a host record. current
+// holds the addresses of the resource in the state, whose network and range
+// are carried over; it is nil for the data source, which has neither.
+func flattenHostIPv4Addrs(addresses []map[string]interface{}, current []interface{}) []interface{} {
+	var result []interface{}
+
+	for n, v := range addresses {
+		i := map[string]interface{}{
+			"address":                v["ipv4addr"],
+			"configure_for_dhcp":     v["configure_for_dhcp"],
+			"mac":                    v["mac"],
+			"use_for_ea_inheritance": v["use_for_ea_inheritance"],
+		}
+		if useOptions, _ := v["use_options"].(bool); useOptions {
+			i["option"] = flattenDHCPOptions(v["options"])
+		}
+		if useBootfile, _ := v["use_bootfile"].(bool); useBootfile {
+			i["bootfile"] = v["bootfile"]
+		}
+		if useNextserver, _ := v["use_nextserver"].(bool); useNextserver {
+			i["nextserver"] = v["nextserver"]
+		}
+		if current != nil {
+			i["network"], i["range"] = hostAllocationFromState(current, n)
+		}
+		result = append(result, i)
+	}
+	return result
+}
+
+// flattenHostIPv6Addrs is flattenHostIPv4Addrs for IPv6 addresses.
+func flattenHostIPv6Addrs(addresses []map[string]interface{}, current []interface{}) []interface{} {
+	var result []interface{}
+
+	for n, v := range addresses {
+		i := map[string]interface{}{
+			"address":            v["ipv6addr"],
+			"configure_for_dhcp": v["configure_for_dhcp"],
+			"mac":                v["mac"],
+			"duid":               v["duid"],
+		}
+		if current != nil {
+			i["network"], i["range"] = hostAllocationFromState(current, n)
+		}
+		result = append(result, i)
+	}
+	return result
 }
 
 func resourceInfobloxHostRecordCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*infoblox.Client)
+	if err := validateHostAddresses(d); err != nil {
+		return err
+	}
+
+	setDefaultView(d, meta)
+	client := meta.(*providerMeta).client
+
+	if err := validateRecordZone(d, meta); err != nil {
+		return err
+	}
 
 	record := url.Values{}
-	hostObject := hostObjectFromAttributes(d)
+	hostObject, err := hostObjectFromAttributes(d, meta)
+	if err != nil {
+		return err
+	}
 
 	log.Printf("[DEBUG] Creating Infoblox Host record with configuration: %#v", hostObject)
 	opts := &infoblox.Options{
@@ -182,79 +492,60 @@ func resourceInfobloxHostRecordCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceInfobloxHostRecordRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*infoblox.Client)
+	return readHostRecord(d, meta, setRecordName, true)
+}
 
-	opts := &infoblox.Options{
-		ReturnFields: []string{"name", "ipv4addrs", "ipv6addrs", "configure_for_dns", "comment", "ttl", "view"},
-	}
-	record, err := client.GetRecordHost(d.Id(), opts)
+// readHostRecord reads the host record of a resource or data source, which
+// set the name of the record with setName. Only the resource has the network
+// and range arguments of its addresses, as told by allocations.
+func readHostRecord(d *schema.ResourceData, meta interface{}, setName func(*schema.ResourceData, string), allocations bool) error {
+	client := meta.(*providerMeta).client
+
+	record, err := wapiGet(client, d.Id(), hostReturnFields)
 	if err != nil {
 		return handleReadError(d, "Host", err)
 	}
 
-	d.Set("name", record.Name)
-
-	if &record.ConfigureForDNS != nil {
-		d.Set("configure_for_dns", record.ConfigureForDNS)
-	}
-
-	if &record.Comment != nil {
-		d.Set("comment", record.Comment)
+	ipv4addrs, err := hostAddressObjects(client, record["ipv4addrs"], hostIPv4AddrReturnFields)
+	if err != nil {
+		return handleReadError(d, "Host", err)
 	}
-
-	if &record.Ttl != nil {
-		d.Set("ttl", record.Ttl)
+	ipv6addrs, err := hostAddressObjects(client, record["ipv6addrs"], hostIPv6AddrReturnFields)
+	if err != nil {
+		return handleReadError(d, "Host", err)
 	}
 
-	if &record.View != nil {
-		d.Set("view", record.View)
-	} else {
-		d.Set("view", "default")
+	name, _ := record["name"].(string)
+	setName(d, name)
+	d.Set("configure_for_dns", record["configure_for_dns"])
+	d.Set("comment", record["comment"])
+	d.Set("view", record["view"])
+	ttl, _ := wapiInt(record["ttl"])
+	d.Set("ttl", ttl)
+
+	var currentIPv4addrs, currentIPv6addrs []interface{}
+	if allocations {
+		currentIPv4addrs = d.Get("ipv4addr").([]interface{})
+		currentIPv6addrs = d.Get("ipv6addr").([]interface{})
 	}
-
-	if &record.Ipv4Addrs != nil {
-		var result []interface{}
-
-		for _, v := range record.Ipv4Addrs {
-			i := make(map[string]interface{})
-
-			i["address"] = v.Ipv4Addr
-			if &v.ConfigureForDHCP != nil {
-				i["configure_for_dhcp"] = v.ConfigureForDHCP
-			}
-			if &v.MAC != nil {
-				i["mac"] = v.MAC
-			}
-
-			result = append(result, i)
-		}
-
-		d.Set("ipv4addr", result)
+	if err := d.Set("ipv4addr", flattenHostIPv4Addrs(ipv4addrs, currentIPv4addrs)); err != nil {
+		return err
 	}
-	if &record.Ipv6Addrs != nil {
-		var result []interface{}
-
-		for _, v := range record.Ipv6Addrs {
-			i := make(map[string]interface{})
-
-			i["address"] = v.Ipv6Addr
-			if &v.ConfigureForDHCP != nil {
-				i["configure_for_dhcp"] = v.ConfigureForDHCP
-			}
-			if &v.MAC != nil {
-				i["mac"] = v.MAC
-			}
-
-			result = append(result, i)
-		}
-		d.Set("ipv6addr", result)
+	if err := d.Set("ipv6addr", flattenHostIPv6Addrs(ipv6addrs, currentIPv6addrs)); err != nil {
+		return err
 	}
+	d.Set("aliases", getMapValueAsStrings(record, "aliases"))
+	d.Set("extensible_attributes", flattenExtAttrs(d, meta, record["extattrs"]))
 
 	return nil
 }
 
 func resourceInfobloxHostRecordUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*infoblox.Client)
+	if err := validateHostAddresses(d); err != nil {
+		return err
+	}
+
+	client := meta.(*providerMeta).client
 
 	opts := &infoblox.Options{
 		ReturnFields: []string{"name", "ipv4addrs", "ipv6addrs", "configure_for_dns", "comment", "ttl", "view"},
@@ -265,7 +556,10 @@ func resourceInfobloxHostRecordUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	record := url.Values{}
-	hostObject := hostObjectFromAttributes(d)
+	hostObject, err := hostObjectFromAttributes(d, meta)
+	if err != nil {
+		return err
+	}
 
 	log.Printf("[DEBUG] Updating Infoblox Host record with configuration: %#v", hostObject)
 
@@ -281,16 +575,11 @@ func resourceInfobloxHostRecordUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceInfobloxHostRecordDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*infoblox.Client)
+	client := meta.(*providerMeta).client
 
 	log.Printf("[DEBUG] Deleting Infoblox Host record: %s, %s", d.Get("name").(string), d.Id())
-	_, err := client.GetRecordHost(d.Id(), nil)
-	if err != nil {
-		return fmt.Errorf("error finding Infoblox Host record: %s", err.Error())
-	}
-
-	err = client.RecordHostObject(d.Id()).Delete(nil)
-	if err != nil {
+	err := wapiDelete(client, d.Id())
+	if err != nil && !isNotFoundError(err) {
 		return fmt.Errorf("error deleting Infoblox Host record: %s", err.Error())
 	}
 
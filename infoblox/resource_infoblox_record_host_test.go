@@ -0,0 +1,26 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestMockInfobloxRecordHost_invalidAddress(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	r := infobloxRecordHost()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name": "app.example.com",
+		"ipv4addr": []interface{}{
+			map[string]interface{}{"network": "10.0.5.0/24", "range": "10.0.5.10-10.0.5.20"},
+		},
+	})
+	if err := r.Create(d, m.meta()); err == nil {
+		t.Fatal("expected an error creating a host address with both network and range")
+	}
+	if n := m.count("record:host"); n != 0 {
+		t.Fatalf("expected no host to be created, found %d", n)
+	}
+}
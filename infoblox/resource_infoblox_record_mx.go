@@ -16,22 +16,34 @@ func infobloxRecordMX() *schema.Resource {
 		Read:   resourceInfobloxMXRecordRead,
 		Update: resourceInfobloxMXRecordUpdate,
 		Delete: resourceInfobloxMXRecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: importInfobloxRecord("record:mx", "exchanger"),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"exchanger": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateFQDN,
 			},
 			"name": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRecordName,
+			},
+			"zone": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateFQDN,
 			},
 			"pref": &schema.Schema{
-				Type:     schema.TypeInt,
-				Required: true,
-				ForceNew: false,
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     false,
+				ValidateFunc: validateUint16,
 			},
 			"comment": &schema.Schema{
 				Type:     schema.TypeString,
@@ -39,23 +51,31 @@ func infobloxRecordMX() *schema.Resource {
 				Default:  "",
 			},
 			"ttl": &schema.Schema{
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateTTL,
 			},
 			"view": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
+			"extensible_attributes": extAttrsSchema(),
 		},
 	}
 }
 
 func resourceInfobloxMXRecordCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*infoblox.Client)
+	setDefaultView(d, meta)
+	client := meta.(*providerMeta).client
+
+	if err := validateRecordZone(d, meta); err != nil {
+		return err
+	}
 
 	record := url.Values{}
 	record.Add("exchanger", d.Get("exchanger").(string))
-	record.Add("name", d.Get("name").(string))
+	record.Add("name", recordFQDN(d))
 	record.Add("pref", strconv.Itoa(d.Get("pref").(int)))
 	populateSharedAttributes(d, &record)
 
@@ -65,8 +85,13 @@ func resourceInfobloxMXRecordCreate(d *schema.ResourceData, meta interface{}) er
 		ReturnFields: []string{"exchanger", "name", "pref", "comment", "ttl", "view"},
 	}
 
+	extAttrs, err := extAttrsFromResourceData(d, meta)
+	if err != nil {
+		return err
+	}
+
 	// TODO: Add MX support to go-infoblox
-	recordID, err := client.RecordMx().Create(record, opts, nil)
+	recordID, err := client.RecordMx().Create(record, opts, extAttrs)
 
 	if err != nil {
 		return fmt.Errorf("error creating infoblox MX record: %s", err.Error())
@@ -79,7 +104,7 @@ func resourceInfobloxMXRecordCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceInfobloxMXRecordRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*infoblox.Client)
+	client := meta.(*providerMeta).client
 
 	opts := &infoblox.Options{
 		ReturnFields: []string{"exchanger", "name", "pref", "comment", "ttl", "view"},
@@ -90,7 +115,7 @@ func resourceInfobloxMXRecordRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	d.Set("exchanger", record.Exchanger)
-	d.Set("name", record.Name)
+	setRecordName(d, record.Name)
 	d.Set("pref", record.Pref)
 
 	if &record.Comment != nil {
@@ -103,11 +128,15 @@ func resourceInfobloxMXRecordRead(d *schema.ResourceData, meta interface{}) erro
 		d.Set("view", record.View)
 	}
 
+	if err := readExtAttrs(d, meta, d.Id()); err != nil {
+		return handleReadError(d, "MX", err)
+	}
+
 	return nil
 }
 
 func resourceInfobloxMXRecordUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*infoblox.Client)
+	client := meta.(*providerMeta).client
 
 	opts := &infoblox.Options{
 		ReturnFields: []string{"exchanger", "name", "pref", "comment", "ttl", "view"},
@@ -118,14 +147,18 @@ func resourceInfobloxMXRecordUpdate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	record := url.Values{}
-	record.Add("exchanger", d.Get("address").(string))
-	record.Add("name", d.Get("name").(string))
+	record.Add("exchanger", d.Get("exchanger").(string))
+	record.Add("name", recordFQDN(d))
 	record.Add("pref", strconv.Itoa(d.Get("pref").(int)))
 	populateSharedAttributes(d, &record)
 
 	log.Printf("[DEBUG] Updating Infoblox MX record with configuration: %#v", record)
 
-	recordID, err := client.RecordMxObject(d.Id()).Update(record, opts, nil)
+	extAttrs, err := extAttrsFromResourceData(d, meta)
+	if err != nil {
+		return err
+	}
+	recordID, err := client.RecordMxObject(d.Id()).Update(record, opts, extAttrs)
 	if err != nil {
 		return fmt.Errorf("error updating Infoblox MX record: %s", err.Error())
 	}
@@ -137,16 +170,11 @@ func resourceInfobloxMXRecordUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceInfobloxMXRecordDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*infoblox.Client)
+	client := meta.(*providerMeta).client
 
 	log.Printf("[DEBUG] Deleting Infoblox MX record: %s, %s", d.Get("name").(string), d.Id())
-	_, err := client.GetRecordMx(d.Id(), nil)
-	if err != nil {
-		return fmt.Errorf("error finding Infoblox MX record: %s", err.Error())
-	}
-
-	err = client.RecordMxObject(d.Id()).Delete(nil)
-	if err != nil {
+	err := wapiDelete(client, d.Id())
+	if err != nil && !isNotFoundError(err) {
 		return fmt.Errorf("error deleting Infoblox MX record: %s", err.Error())
 	}
 
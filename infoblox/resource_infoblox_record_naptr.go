@@ -0,0 +1,206 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func infobloxRecordNAPTR() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInfobloxNAPTRRecordCreate,
+		Read:   resourceInfobloxNAPTRRecordRead,
+		Update: resourceInfobloxNAPTRRecordUpdate,
+		Delete: resourceInfobloxNAPTRRecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: importInfobloxRecord("record:naptr", "replacement"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateHostName,
+			},
+			"zone": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateFQDN,
+			},
+			"order": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateUint16,
+			},
+			"preference": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateUint16,
+			},
+			"flags": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "",
+				ValidateFunc: validateNAPTRFlags,
+			},
+			"services": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "",
+				ValidateFunc: validateNAPTRServices,
+			},
+			"regexp": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "",
+				ValidateFunc: validateNAPTRRegexp,
+			},
+			"replacement": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ".",
+				ValidateFunc: validateNAPTRReplacement,
+			},
+			"comment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			"ttl": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateTTL,
+			},
+			"view": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"extensible_attributes": extAttrsSchema(),
+		},
+	}
+}
+
+// naptrRecordReturnFields lists the fields we read back for NAPTR records.
+var naptrRecordReturnFields = append([]string{
+	"order", "preference", "flags", "services", "regexp", "replacement",
+}, sharedRecordReturnFields...)
+
+// naptrRecordObjectFromAttributes builds the body of a record:naptr create or
+// update request from the attributes as set by terraform.
+func naptrRecordObjectFromAttributes(d *schema.ResourceData, meta interface{}) (map[string]interface{}, error) {
+	substitution := d.Get("regexp").(string)
+	replacement := d.Get("replacement").(string)
+
+	// RFC 3403 rewrites a NAPTR either with its regexp or by replacing the
+	// name with its replacement, never both.
+	if substitution != "" && replacement != "." {
+		return nil, fmt.Errorf("'replacement' must be . for a NAPTR record with a 'regexp', got %q", replacement)
+	}
+
+	record := map[string]interface{}{
+		"name":        recordFQDN(d),
+		"order":       d.Get("order").(int),
+		"preference":  d.Get("preference").(int),
+		"flags":       d.Get("flags").(string),
+		"services":    d.Get("services").(string),
+		"regexp":      substitution,
+		"replacement": replacement,
+	}
+	populateSharedFields(d, record)
+
+	extAttrs, err := extAttrsFromResourceData(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	extAttrs.addTo(record)
+
+	return record, nil
+}
+
+func resourceInfobloxNAPTRRecordCreate(d *schema.ResourceData, meta interface{}) error {
+	setDefaultView(d, meta)
+	client := meta.(*providerMeta).client
+
+	if err := validateRecordZone(d, meta); err != nil {
+		return err
+	}
+
+	record, err := naptrRecordObjectFromAttributes(d, meta)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating Infoblox NAPTR record with configuration: %#v", record)
+
+	recordID, err := wapiCreate(client, "record:naptr", record)
+	if err != nil {
+		return fmt.Errorf("error creating Infoblox NAPTR record: %s", err.Error())
+	}
+
+	d.SetId(recordID)
+	log.Printf("[INFO] Infoblox NAPTR record created with ID: %s", d.Id())
+
+	return resourceInfobloxNAPTRRecordRead(d, meta)
+}
+
+func resourceInfobloxNAPTRRecordRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	record, err := wapiGet(client, d.Id(), naptrRecordReturnFields)
+	if err != nil {
+		return handleReadError(d, "NAPTR", err)
+	}
+
+	readSharedFields(d, meta, record)
+	d.Set("flags", record["flags"])
+	d.Set("services", record["services"])
+	d.Set("regexp", record["regexp"])
+	d.Set("replacement", record["replacement"])
+
+	if order, ok := wapiInt(record["order"]); ok {
+		d.Set("order", order)
+	}
+	if preference, ok := wapiInt(record["preference"]); ok {
+		d.Set("preference", preference)
+	}
+
+	return nil
+}
+
+func resourceInfobloxNAPTRRecordUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	record, err := naptrRecordObjectFromAttributes(d, meta)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Infoblox NAPTR record with configuration: %#v", record)
+
+	recordID, err := wapiUpdate(client, d.Id(), record)
+	if err != nil {
+		return fmt.Errorf("error updating Infoblox NAPTR record: %s", err.Error())
+	}
+
+	d.SetId(recordID)
+	log.Printf("[INFO] Infoblox NAPTR record updated with ID: %s", d.Id())
+
+	return resourceInfobloxNAPTRRecordRead(d, meta)
+}
+
+func resourceInfobloxNAPTRRecordDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	log.Printf("[DEBUG] Deleting Infoblox NAPTR record: %s, %s", d.Get("name").(string), d.Id())
+
+	err := wapiDelete(client, d.Id())
+	if err != nil && !isNotFoundError(err) {
+		return fmt.Errorf("error deleting Infoblox NAPTR record: %s", err.Error())
+	}
+
+	return nil
+}
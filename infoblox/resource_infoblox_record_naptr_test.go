@@ -0,0 +1,24 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestMockInfobloxRecordNAPTR_regexpAndReplacement(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	r := infobloxRecordNAPTR()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":        "example.com",
+		"order":       10,
+		"preference":  100,
+		"regexp":      "!^.*$!sip:info@example.com!",
+		"replacement": "_sip._udp.example.com",
+	})
+	if err := r.Create(d, m.meta()); err == nil {
+		t.Fatal("expected an error creating a NAPTR record with both a regexp and a replacement")
+	}
+}
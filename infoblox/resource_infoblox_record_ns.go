@@ -0,0 +1,193 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// nsAddressSchema represents the schema for an address of the name server of
+// an NS record
+func nsAddressSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"address": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateIPAddress,
+		},
+		"auto_create_ptr": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+}
+
+func infobloxRecordNS() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInfobloxNSRecordCreate,
+		Read:   resourceInfobloxNSRecordRead,
+		Update: resourceInfobloxNSRecordUpdate,
+		Delete: resourceInfobloxNSRecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: importInfobloxRecord("record:ns", "nameserver"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateHostName,
+			},
+			"zone": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateFQDN,
+			},
+			"nameserver": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateFQDN,
+			},
+			"addresses": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Resource{Schema: nsAddressSchema()},
+			},
+			"view": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+// nsRecordReturnFields lists the fields we read back for NS records.
+var nsRecordReturnFields = []string{"name", "nameserver", "addresses", "view"}
+
+func nsAddressesFromList(addresses []interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(addresses))
+
+	for _, v := range addresses {
+		addressMap := v.(map[string]interface{})
+		result = append(result, map[string]interface{}{
+			"address":         addressMap["address"].(string),
+			"auto_create_ptr": addressMap["auto_create_ptr"].(bool),
+		})
+	}
+	return result
+}
+
+func flattenNSAddresses(addresses interface{}) []interface{} {
+	var result []interface{}
+
+	list, _ := addresses.([]interface{})
+	for _, v := range list {
+		address, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		a := map[string]interface{}{"address": address["address"]}
+		if val, ok := address["auto_create_ptr"].(bool); ok {
+			a["auto_create_ptr"] = val
+		}
+		result = append(result, a)
+	}
+	return result
+}
+
+// nsRecordObjectFromAttributes builds the body of a record:ns create or update
+// request from the attributes as set by terraform.
+// The Infoblox WAPI does not allow the name or view of an existing NS record
+// to be changed, so we take an isUpdate arg to skip setting them.
+func nsRecordObjectFromAttributes(d *schema.ResourceData, isUpdate bool) map[string]interface{} {
+	record := make(map[string]interface{})
+
+	if !isUpdate {
+		record["name"] = recordFQDN(d)
+		record["view"] = d.Get("view").(string)
+	}
+
+	record["nameserver"] = d.Get("nameserver").(string)
+	record["addresses"] = nsAddressesFromList(d.Get("addresses").([]interface{}))
+
+	return record
+}
+
+func resourceInfobloxNSRecordCreate(d *schema.ResourceData, meta interface{}) error {
+	setDefaultView(d, meta)
+	client := meta.(*providerMeta).client
+
+	if err := validateRecordZone(d, meta); err != nil {
+		return err
+	}
+
+	record := nsRecordObjectFromAttributes(d, false)
+
+	log.Printf("[DEBUG] Creating Infoblox NS record with configuration: %#v", record)
+
+	recordID, err := wapiCreate(client, "record:ns", record)
+	if err != nil {
+		return fmt.Errorf("error creating Infoblox NS record: %s", err.Error())
+	}
+
+	d.SetId(recordID)
+	log.Printf("[INFO] Infoblox NS record created with ID: %s", d.Id())
+
+	return resourceInfobloxNSRecordRead(d, meta)
+}
+
+func resourceInfobloxNSRecordRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	record, err := wapiGet(client, d.Id(), nsRecordReturnFields)
+	if err != nil {
+		return handleReadError(d, "NS", err)
+	}
+
+	name, _ := record["name"].(string)
+	setRecordName(d, name)
+	d.Set("nameserver", record["nameserver"])
+	d.Set("addresses", flattenNSAddresses(record["addresses"]))
+	d.Set("view", record["view"])
+
+	return nil
+}
+
+func resourceInfobloxNSRecordUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	record := nsRecordObjectFromAttributes(d, true)
+
+	log.Printf("[DEBUG] Updating Infoblox NS record with configuration: %#v", record)
+
+	recordID, err := wapiUpdate(client, d.Id(), record)
+	if err != nil {
+		return fmt.Errorf("error updating Infoblox NS record: %s", err.Error())
+	}
+
+	d.SetId(recordID)
+	log.Printf("[INFO] Infoblox NS record updated with ID: %s", d.Id())
+
+	return resourceInfobloxNSRecordRead(d, meta)
+}
+
+func resourceInfobloxNSRecordDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	log.Printf("[DEBUG] Deleting Infoblox NS record: %s, %s", d.Get("name").(string), d.Id())
+
+	err := wapiDelete(client, d.Id())
+	if err != nil && !isNotFoundError(err) {
+		return fmt.Errorf("error deleting Infoblox NS record: %s", err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package infoblox
+
+import (
+	"testing"
+)
+
+func TestMockInfobloxRecordNSImport(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	ref := m.add("record:ns", map[string]interface{}{
+		"name":       "aws.example.com",
+		"nameserver": "ns-1.awsdns-01.org",
+		"view":       "default",
+	})
+	m.add("record:ns", map[string]interface{}{
+		"name":       "aws.example.com",
+		"nameserver": "ns-2.awsdns-02.net",
+		"view":       "default",
+	})
+
+	r := infobloxRecordNS()
+	d := r.TestResourceData()
+	d.SetId("default/aws.example.com/ns-1.awsdns-01.org")
+
+	imported, err := r.Importer.State(d, m.meta())
+	if err != nil {
+		t.Fatalf("error importing record:ns: %s", err)
+	}
+	if imported[0].Id() != ref {
+		t.Fatalf("expected import to resolve to %s, got %s", ref, imported[0].Id())
+	}
+
+	d = r.TestResourceData()
+	d.SetId("default/aws.example.com")
+	if _, err := r.Importer.State(d, m.meta()); err == nil {
+		t.Fatal("expected an error importing one of several record:ns without a nameserver")
+	}
+}
@@ -15,23 +15,37 @@ func infobloxRecordPTR() *schema.Resource {
 		Read:   resourceInfobloxPTRRecordRead,
 		Update: resourceInfobloxPTRRecordUpdate,
 		Delete: resourceInfobloxPTRRecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: importInfobloxRecord("record:ptr", "ptrdname"),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"address": &schema.Schema{
 				Type:          schema.TypeString,
 				Optional:      true,
+				Computed:      true,
 				ForceNew:      true,
 				ConflictsWith: []string{"name"},
+				ValidateFunc:  validateIPAddress,
 			},
 			"ptrdname": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateFQDN,
 			},
 			"name": &schema.Schema{
 				Type:          schema.TypeString,
 				Optional:      true,
+				Computed:      true,
 				ConflictsWith: []string{"address"},
+				ValidateFunc:  validateHostName,
+			},
+			"zone": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateFQDN,
 			},
 			"comment": &schema.Schema{
 				Type:     schema.TypeString,
@@ -39,13 +53,16 @@ func infobloxRecordPTR() *schema.Resource {
 				Default:  "",
 			},
 			"ttl": &schema.Schema{
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateTTL,
 			},
 			"view": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
+			"extensible_attributes": extAttrsSchema(),
 		},
 	}
 }
@@ -56,7 +73,13 @@ func resourceInfobloxPTRRecordCreate(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	client := meta.(*infoblox.Client)
+	setDefaultView(d, meta)
+	client := meta.(*providerMeta).client
+
+	if err := validateRecordZone(d, meta); err != nil {
+		return err
+	}
+
 	record := url.Values{}
 
 	if attr, ok := d.GetOk("address"); ok {
@@ -66,7 +89,7 @@ func resourceInfobloxPTRRecordCreate(d *schema.ResourceData, meta interface{}) e
 		}
 		record.Add(addressType, attr.(string))
 	} else {
-		record.Add("name", d.Get("name").(string))
+		record.Add("name", recordFQDN(d))
 	}
 	record.Add("ptrdname", d.Get("ptrdname").(string))
 	populateSharedAttributes(d, &record)
@@ -74,7 +97,11 @@ func resourceInfobloxPTRRecordCreate(d *schema.ResourceData, meta interface{}) e
 	log.Printf("[DEBUG] Creating Infoblox PTR record with configuration: %#v", record)
 
 	opts := ptrOpts(d)
-	recordID, err := client.RecordPtr().Create(record, opts, nil)
+	extAttrs, err := extAttrsFromResourceData(d, meta)
+	if err != nil {
+		return err
+	}
+	recordID, err := client.RecordPtr().Create(record, opts, extAttrs)
 
 	if err != nil {
 		return fmt.Errorf("error creating infoblox PTR record: %s", err.Error())
@@ -87,7 +114,7 @@ func resourceInfobloxPTRRecordCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceInfobloxPTRRecordRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*infoblox.Client)
+	client := meta.(*providerMeta).client
 
 	opts := ptrOpts(d)
 	record, err := client.GetRecordPtr(d.Id(), opts)
@@ -97,14 +124,14 @@ func resourceInfobloxPTRRecordRead(d *schema.ResourceData, meta interface{}) err
 
 	d.Set("ptrdname", record.PtrDname)
 
-	if &record.Ipv4Addr != nil {
+	if record.Ipv4Addr != "" {
 		d.Set("address", record.Ipv4Addr)
 	}
-	if &record.Ipv6Addr != nil {
+	if record.Ipv6Addr != "" {
 		d.Set("address", record.Ipv6Addr)
 	}
 	if &record.Name != nil {
-		d.Set("name", record.Name)
+		setRecordName(d, record.Name)
 	}
 	if &record.Comment != nil {
 		d.Set("comment", record.Comment)
@@ -116,13 +143,23 @@ func resourceInfobloxPTRRecordRead(d *schema.ResourceData, meta interface{}) err
 		d.Set("view", record.View)
 	}
 
+	if err := readExtAttrs(d, meta, d.Id()); err != nil {
+		return handleReadError(d, "PTR", err)
+	}
+
 	return nil
 }
 
 func resourceInfobloxPTRRecordUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*infoblox.Client)
+	client := meta.(*providerMeta).client
 	record := url.Values{}
 
+	if d.HasChange("name") {
+		if err := validateRecordZone(d, meta); err != nil {
+			return err
+		}
+	}
+
 	opts := ptrOpts(d)
 	_, err := client.GetRecordPtr(d.Id(), opts)
 	if err != nil {
@@ -136,14 +173,18 @@ func resourceInfobloxPTRRecordUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 		record.Add(addressType, attr.(string))
 	} else {
-		record.Add("name", d.Get("name").(string))
+		record.Add("name", recordFQDN(d))
 	}
 	record.Add("ptrdname", d.Get("ptrdname").(string))
 	populateSharedAttributes(d, &record)
 
 	log.Printf("[DEBUG] Updating Infoblox PTR record with configuration: %#v", record)
 
-	recordID, err := client.RecordPtrObject(d.Id()).Update(record, opts, nil)
+	extAttrs, err := extAttrsFromResourceData(d, meta)
+	if err != nil {
+		return err
+	}
+	recordID, err := client.RecordPtrObject(d.Id()).Update(record, opts, extAttrs)
 	if err != nil {
 		return fmt.Errorf("error updating Infoblox PTR record: %s", err.Error())
 	}
@@ -155,16 +196,11 @@ func resourceInfobloxPTRRecordUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceInfobloxPTRRecordDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*infoblox.Client)
+	client := meta.(*providerMeta).client
 
 	log.Printf("[DEBUG] Deleting Infoblox PTR record: %s, %s", d.Get("ptrdname").(string), d.Id())
-	_, err := client.GetRecordPtr(d.Id(), nil)
-	if err != nil {
-		return fmt.Errorf("error finding Infoblox PTR record: %s", err.Error())
-	}
-
-	err = client.RecordPtrObject(d.Id()).Delete(nil)
-	if err != nil {
+	err := wapiDelete(client, d.Id())
+	if err != nil && !isNotFoundError(err) {
 		return fmt.Errorf("error deleting Infoblox PTR record: %s", err.Error())
 	}
 
@@ -185,21 +221,11 @@ func validatePTRFields(d *schema.ResourceData) error {
 	return nil
 }
 
-// A PTR object can have either an ipv4/ipv6 address or a name, so when
-// constructing our ReturnFields slice we read the Schema to see which we want
-// to return.
+// A PTR object can have either an ipv4/ipv6 address or a name. We always ask
+// for all three so that Read can populate whichever is set, which is what
+// makes an imported PTR record usable without knowing its shape up front.
 func ptrOpts(d *schema.ResourceData) *infoblox.Options {
-	opts := []string{"ptrdname", "ttl", "comment", "view"}
-
-	if _, ok := d.GetOk("name"); ok {
-		opts = append(opts, "name")
+	return &infoblox.Options{
+		ReturnFields: []string{"ptrdname", "ttl", "comment", "view", "name", "ipv4addr", "ipv6addr"},
 	}
-	if _, ok := d.GetOk("ipv4addr"); ok {
-		opts = append(opts, "ipv4addr")
-	}
-	if _, ok := d.GetOk("ipv6addr"); ok {
-		opts = append(opts, "ipv6addr")
-	}
-
-	return &infoblox.Options{ReturnFields: opts}
 }
@@ -16,32 +16,46 @@ func infobloxRecordSRV() *schema.Resource {
 		Read:   resourceInfobloxSRVRecordRead,
 		Update: resourceInfobloxSRVRecordUpdate,
 		Delete: resourceInfobloxSRVRecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: importInfobloxRecord("record:srv", "target"),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRecordName,
+			},
+			"zone": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateFQDN,
 			},
 			"port": &schema.Schema{
-				Type:     schema.TypeInt,
-				Required: true,
-				ForceNew: false,
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     false,
+				ValidateFunc: validateUint16,
 			},
 			"priority": &schema.Schema{
-				Type:     schema.TypeInt,
-				Required: true,
-				ForceNew: false,
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     false,
+				ValidateFunc: validateUint16,
 			},
 			"target": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "",
+				ValidateFunc: validateFQDN,
 			},
 			"weight": &schema.Schema{
-				Type:     schema.TypeInt,
-				Required: true,
-				ForceNew: false,
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     false,
+				ValidateFunc: validateUint16,
 			},
 			"comment": &schema.Schema{
 				Type:     schema.TypeString,
@@ -49,22 +63,30 @@ func infobloxRecordSRV() *schema.Resource {
 				Default:  "",
 			},
 			"ttl": &schema.Schema{
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateTTL,
 			},
 			"view": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
+			"extensible_attributes": extAttrsSchema(),
 		},
 	}
 }
 
 func resourceInfobloxSRVRecordCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*infoblox.Client)
+	setDefaultView(d, meta)
+	client := meta.(*providerMeta).client
+
+	if err := validateRecordZone(d, meta); err != nil {
+		return err
+	}
 
 	record := url.Values{}
-	record.Add("name", d.Get("name").(string))
+	record.Add("name", recordFQDN(d))
 	record.Add("port", strconv.Itoa(d.Get("port").(int)))
 	record.Add("priority", strconv.Itoa(d.Get("priority").(int)))
 	record.Add("target", d.Get("target").(string))
@@ -77,8 +99,13 @@ func resourceInfobloxSRVRecordCreate(d *schema.ResourceData, meta interface{}) e
 		ReturnFields: []string{"name", "port", "priority", "target", "weight", "comment", "ttl", "view"},
 	}
 
+	extAttrs, err := extAttrsFromResourceData(d, meta)
+	if err != nil {
+		return err
+	}
+
 	// TODO: Add SRV support to go-infoblox
-	recordID, err := client.RecordSrv().Create(record, opts, nil)
+	recordID, err := client.RecordSrv().Create(record, opts, extAttrs)
 
 	if err != nil {
 		return fmt.Errorf("error creating infoblox SRV record: %s", err.Error())
@@ -91,7 +118,7 @@ func resourceInfobloxSRVRecordCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceInfobloxSRVRecordRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*infoblox.Client)
+	client := meta.(*providerMeta).client
 
 	opts := &infoblox.Options{
 		ReturnFields: []string{"name", "port", "priority", "target", "weight", "comment", "ttl", "view"},
@@ -101,7 +128,7 @@ func resourceInfobloxSRVRecordRead(d *schema.ResourceData, meta interface{}) err
 		return handleReadError(d, "SRV", err)
 	}
 
-	d.Set("name", record.Name)
+	setRecordName(d, record.Name)
 	d.Set("port", record.Port)
 	d.Set("priority", record.Priority)
 	d.Set("target", record.Target)
@@ -117,11 +144,15 @@ func resourceInfobloxSRVRecordRead(d *schema.ResourceData, meta interface{}) err
 		d.Set("view", record.View)
 	}
 
+	if err := readExtAttrs(d, meta, d.Id()); err != nil {
+		return handleReadError(d, "SRV", err)
+	}
+
 	return nil
 }
 
 func resourceInfobloxSRVRecordUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*infoblox.Client)
+	client := meta.(*providerMeta).client
 
 	opts := &infoblox.Options{
 		ReturnFields: []string{"name", "port", "priority", "target", "weight", "comment", "ttl", "view"},
@@ -138,7 +169,7 @@ func resourceInfobloxSRVRecordUpdate(d *schema.ResourceData, meta interface{}) e
 	// target fqdn/string
 	// weight int
 	// shared
-	record.Add("name", d.Get("name").(string))
+	record.Add("name", recordFQDN(d))
 	record.Add("port", strconv.Itoa(d.Get("port").(int)))
 	record.Add("priority", strconv.Itoa(d.Get("priority").(int)))
 	record.Add("target", d.Get("target").(string))
@@ -147,7 +178,11 @@ func resourceInfobloxSRVRecordUpdate(d *schema.ResourceData, meta interface{}) e
 
 	log.Printf("[DEBUG] Updating Infoblox SRV record with configuration: %#v", record)
 
-	recordID, err := client.RecordSrvObject(d.Id()).Update(record, opts, nil)
+	extAttrs, err := extAttrsFromResourceData(d, meta)
+	if err != nil {
+		return err
+	}
+	recordID, err := client.RecordSrvObject(d.Id()).Update(record, opts, extAttrs)
 	if err != nil {
 		return fmt.Errorf("error updating Infoblox SRV record: %s", err.Error())
 	}
@@ -159,16 +194,11 @@ func resourceInfobloxSRVRecordUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceInfobloxSRVRecordDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*infoblox.Client)
+	client := meta.(*providerMeta).client
 
 	log.Printf("[DEBUG] Deleting Infoblox SRV record: %s, %s", d.Get("name").(string), d.Id())
-	_, err := client.GetRecordSrv(d.Id(), nil)
-	if err != nil {
-		return fmt.Errorf("error finding Infoblox SRV record: %s", err.Error())
-	}
-
-	err = client.RecordSrvObject(d.Id()).Delete(nil)
-	if err != nil {
+	err := wapiDelete(client, d.Id())
+	if err != nil && !isNotFoundError(err) {
 		return fmt.Errorf("error deleting Infoblox SRV record: %s", err.Error())
 	}
 
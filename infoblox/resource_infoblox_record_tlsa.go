@@ -0,0 +1,189 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// tlsaDigestLengths maps the matched_type of a TLSA record to the length in
+// bytes of its certificate data: a SHA-256 or SHA-512 digest for 1 and 2, or
+// the full certificate or public key for 0.
+var tlsaDigestLengths = map[int]int{1: 32, 2: 64}
+
+func infobloxRecordTLSA() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInfobloxTLSARecordCreate,
+		Read:   resourceInfobloxTLSARecordRead,
+		Update: resourceInfobloxTLSARecordUpdate,
+		Delete: resourceInfobloxTLSARecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: importInfobloxRecord("record:tlsa", "certificate_data"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateTLSAName,
+			},
+			"zone": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateFQDN,
+			},
+			"certificate_usage": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateIntBetween(0, 3),
+			},
+			"selector": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateIntBetween(0, 1),
+			},
+			"matched_type": &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateIntBetween(0, 2),
+			},
+			"certificate_data": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateHex,
+			},
+			"comment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			"ttl": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateTTL,
+			},
+			"view": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"extensible_attributes": extAttrsSchema(),
+		},
+	}
+}
+
+// tlsaRecordReturnFields lists the fields we read back for TLSA records.
+var tlsaRecordReturnFields = append([]string{
+	"certificate_usage", "selector", "matched_type", "certificate_data",
+}, sharedRecordReturnFields...)
+
+// tlsaRecordObjectFromAttributes builds the body of a record:tlsa create or
+// update request from the attributes as set by terraform.
+func tlsaRecordObjectFromAttributes(d *schema.ResourceData, meta interface{}) (map[string]interface{}, error) {
+	matchedType := d.Get("matched_type").(int)
+	data := d.Get("certificate_data").(string)
+
+	if length, ok := tlsaDigestLengths[matchedType]; ok && len(data) != 2*length {
+		return nil, fmt.Errorf("the certificate_data of a TLSA record with matched_type %d must be %d hex octets, got %d",
+			matchedType, length, len(data)/2)
+	}
+
+	record := map[string]interface{}{
+		"name":              recordFQDN(d),
+		"certificate_usage": d.Get("certificate_usage").(int),
+		"selector":          d.Get("selector").(int),
+		"matched_type":      matchedType,
+		"certificate_data":  data,
+	}
+	populateSharedFields(d, record)
+
+	extAttrs, err := extAttrsFromResourceData(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	extAttrs.addTo(record)
+
+	return record, nil
+}
+
+func resourceInfobloxTLSARecordCreate(d *schema.ResourceData, meta interface{}) error {
+	setDefaultView(d, meta)
+	client := meta.(*providerMeta).client
+
+	if err := validateRecordZone(d, meta); err != nil {
+		return err
+	}
+
+	record, err := tlsaRecordObjectFromAttributes(d, meta)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating Infoblox TLSA record with configuration: %#v", record)
+
+	recordID, err := wapiCreate(client, "record:tlsa", record)
+	if err != nil {
+		return fmt.Errorf("error creating Infoblox TLSA record: %s", err.Error())
+	}
+
+	d.SetId(recordID)
+	log.Printf("[INFO] Infoblox TLSA record created with ID: %s", d.Id())
+
+	return resourceInfobloxTLSARecordRead(d, meta)
+}
+
+func resourceInfobloxTLSARecordRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	record, err := wapiGet(client, d.Id(), tlsaRecordReturnFields)
+	if err != nil {
+		return handleReadError(d, "TLSA", err)
+	}
+
+	readSharedFields(d, meta, record)
+	d.Set("certificate_data", record["certificate_data"])
+	for _, field := range []string{"certificate_usage", "selector", "matched_type"} {
+		if v, ok := wapiInt(record[field]); ok {
+			d.Set(field, v)
+		}
+	}
+
+	return nil
+}
+
+func resourceInfobloxTLSARecordUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	record, err := tlsaRecordObjectFromAttributes(d, meta)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Infoblox TLSA record with configuration: %#v", record)
+
+	recordID, err := wapiUpdate(client, d.Id(), record)
+	if err != nil {
+		return fmt.Errorf("error updating Infoblox TLSA record: %s", err.Error())
+	}
+
+	d.SetId(recordID)
+	log.Printf("[INFO] Infoblox TLSA record updated with ID: %s", d.Id())
+
+	return resourceInfobloxTLSARecordRead(d, meta)
+}
+
+func resourceInfobloxTLSARecordDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	log.Printf("[DEBUG] Deleting Infoblox TLSA record: %s, %s", d.Get("name").(string), d.Id())
+
+	err := wapiDelete(client, d.Id())
+	if err != nil && !isNotFoundError(err) {
+		return fmt.Errorf("error deleting Infoblox TLSA record: %s", err.Error())
+	}
+
+	return nil
+}
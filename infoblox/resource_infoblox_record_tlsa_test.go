@@ -0,0 +1,24 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestMockInfobloxRecordTLSA_digestLength(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	r := infobloxRecordTLSA()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":              "_443._tcp.www.example.com",
+		"certificate_usage": 3,
+		"selector":          1,
+		"matched_type":      2,
+		"certificate_data":  "0c72ac70b745ac19998811b131d662c9ac69dbdbe7cb23e5b514b56664c5d3d6",
+	})
+	if err := r.Create(d, m.meta()); err == nil {
+		t.Fatal("expected an error creating a TLSA record with a SHA-256 digest and matched_type 2")
+	}
+}
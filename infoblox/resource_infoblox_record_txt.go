@@ -15,12 +15,22 @@ func infobloxRecordTXT() *schema.Resource {
 		Read:   resourceInfobloxTXTRecordRead,
 		Update: resourceInfobloxTXTRecordUpdate,
 		Delete: resourceInfobloxTXTRecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: importInfobloxRecord("record:txt", "text"),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRecordName,
+			},
+			"zone": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateFQDN,
 			},
 			"text": &schema.Schema{
 				Type:     schema.TypeString,
@@ -33,22 +43,30 @@ func infobloxRecordTXT() *schema.Resource {
 				Default:  "",
 			},
 			"ttl": &schema.Schema{
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateTTL,
 			},
 			"view": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
+			"extensible_attributes": extAttrsSchema(),
 		},
 	}
 }
 
 func resourceInfobloxTXTRecordCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*infoblox.Client)
+	setDefaultView(d, meta)
+	client := meta.(*providerMeta).client
+
+	if err := validateRecordZone(d, meta); err != nil {
+		return err
+	}
 
 	record := url.Values{}
-	record.Add("name", d.Get("name").(string))
+	record.Add("name", recordFQDN(d))
 	record.Add("text", d.Get("text").(string))
 	populateSharedAttributes(d, &record)
 
@@ -58,7 +76,11 @@ func resourceInfobloxTXTRecordCreate(d *schema.ResourceData, meta interface{}) e
 		ReturnFields: []string{"name", "text", "comment", "ttl", "view"},
 	}
 
-	recordID, err := client.RecordTxt().Create(record, opts, nil)
+	extAttrs, err := extAttrsFromResourceData(d, meta)
+	if err != nil {
+		return err
+	}
+	recordID, err := client.RecordTxt().Create(record, opts, extAttrs)
 
 	if err != nil {
 		return fmt.Errorf("error creating infoblox TXT record: %s", err.Error())
@@ -71,7 +93,7 @@ func resourceInfobloxTXTRecordCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceInfobloxTXTRecordRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*infoblox.Client)
+	client := meta.(*providerMeta).client
 
 	opts := &infoblox.Options{
 		ReturnFields: []string{"name", "text", "comment", "ttl", "view"},
@@ -81,7 +103,7 @@ func resourceInfobloxTXTRecordRead(d *schema.ResourceData, meta interface{}) err
 		return handleReadError(d, "TXT", err)
 	}
 
-	d.Set("name", record.Name)
+	setRecordName(d, record.Name)
 	d.Set("text", record.Text)
 
 	if &record.Comment != nil {
@@ -94,11 +116,15 @@ func resourceInfobloxTXTRecordRead(d *schema.ResourceData, meta interface{}) err
 		d.Set("view", record.View)
 	}
 
+	if err := readExtAttrs(d, meta, d.Id()); err != nil {
+		return handleReadError(d, "TXT", err)
+	}
+
 	return nil
 }
 
 func resourceInfobloxTXTRecordUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*infoblox.Client)
+	client := meta.(*providerMeta).client
 
 	opts := &infoblox.Options{
 		ReturnFields: []string{"name", "text", "comment", "ttl", "view"},
@@ -109,13 +135,17 @@ func resourceInfobloxTXTRecordUpdate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	record := url.Values{}
-	record.Add("name", d.Get("name").(string))
+	record.Add("name", recordFQDN(d))
 	record.Add("text", d.Get("text").(string))
 	populateSharedAttributes(d, &record)
 
 	log.Printf("[DEBUG] Updating Infoblox TXT record with configuration: %#v", record)
 
-	recordID, err := client.RecordTxtObject(d.Id()).Update(record, opts, nil)
+	extAttrs, err := extAttrsFromResourceData(d, meta)
+	if err != nil {
+		return err
+	}
+	recordID, err := client.RecordTxtObject(d.Id()).Update(record, opts, extAttrs)
 	if err != nil {
 		return fmt.Errorf("error updating Infoblox TXT record: %s", err.Error())
 	}
@@ -127,16 +157,11 @@ func resourceInfobloxTXTRecordUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceInfobloxTXTRecordDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*infoblox.Client)
+	client := meta.(*providerMeta).client
 
 	log.Printf("[DEBUG] Deleting Infoblox TXT record: %s, %s", d.Get("name").(string), d.Id())
-	_, err := client.GetRecordTxt(d.Id(), nil)
-	if err != nil {
-		return fmt.Errorf("error finding Infoblox TXT record: %s", err.Error())
-	}
-
-	err = client.RecordTxtObject(d.Id()).Delete(nil)
-	if err != nil {
+	err := wapiDelete(client, d.Id())
+	if err != nil && !isNotFoundError(err) {
 		return fmt.Errorf("error deleting Infoblox TXT record: %s", err.Error())
 	}
 
@@ -0,0 +1,429 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	infoblox "github.com/fanatic/go-infoblox"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Shared records belong to a shared record group and are served from every
+// zone the group is associated with. Their names are relative to those zones,
+// with "@" for the apex of each of them. The sharedrecord:* object types only
+// differ in their record specific fields, so their resources are all built by
+// infobloxSharedRecord from a sharedRecordType.
+
+// sharedRecordField is an argument of a shared record resource together with
+// the WAPI field it is sent as.
+type sharedRecordField struct {
+	wapiField string
+	schema    *schema.Schema
+}
+
+// sharedRecordType describes one of the sharedrecord:* object types.
+type sharedRecordType struct {
+	// objectType is the WAPI object type, e.g. "sharedrecord:mx".
+	objectType string
+	// recordType is the record type used in messages, e.g. "MX".
+	recordType string
+	// valueField is the WAPI field the value of import IDs is matched
+	// against.
+	valueField string
+	// fields maps the record specific arguments to their WAPI fields.
+	fields map[string]sharedRecordField
+	// validate, if set, checks the record before it is sent to WAPI.
+	validate func(d *schema.ResourceData) error
+}
+
+func infobloxSharedRecordA() *schema.Resource {
+	return infobloxSharedRecord(sharedRecordType{
+		objectType: "sharedrecord:a",
+		recordType: "A",
+		valueField: "ipv4addr",
+		fields: map[string]sharedRecordField{
+			"address": {"ipv4addr", &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateIPv4Address,
+			}},
+		},
+	})
+}
+
+func infobloxSharedRecordAAAA() *schema.Resource {
+	return infobloxSharedRecord(sharedRecordType{
+		objectType: "sharedrecord:aaaa",
+		recordType: "AAAA",
+		valueField: "ipv6addr",
+		fields: map[string]sharedRecordField{
+			"address": {"ipv6addr", &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateIPv6Address,
+			}},
+		},
+	})
+}
+
+func infobloxSharedRecordCNAME() *schema.Resource {
+	return infobloxSharedRecord(sharedRecordType{
+		objectType: "sharedrecord:cname",
+		recordType: "CNAME",
+		valueField: "canonical",
+		fields: map[string]sharedRecordField{
+			"canonical": {"canonical", &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateFQDN,
+			}},
+		},
+		validate: func(d *schema.ResourceData) error {
+			if d.Get("name").(string) == "@" {
+				return fmt.Errorf("a CNAME record cannot be at the apex of a zone")
+			}
+			return nil
+		},
+	})
+}
+
+func infobloxSharedRecordMX() *schema.Resource {
+	return infobloxSharedRecord(sharedRecordType{
+		objectType: "sharedrecord:mx",
+		recordType: "MX",
+		valueField: "mail_exchanger",
+		fields: map[string]sharedRecordField{
+			"exchanger": {"mail_exchanger", &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateFQDN,
+			}},
+			"pref": {"preference", &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateUint16,
+			}},
+		},
+	})
+}
+
+func infobloxSharedRecordSRV() *schema.Resource {
+	return infobloxSharedRecord(sharedRecordType{
+		objectType: "sharedrecord:srv",
+		recordType: "SRV",
+		valueField: "target",
+		fields: map[string]sharedRecordField{
+			"port": {"port", &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateUint16,
+			}},
+			"priority": {"priority", &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateUint16,
+			}},
+			"weight": {"weight", &schema.Schema{
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateUint16,
+			}},
+			"target": {"target", &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateFQDN,
+			}},
+		},
+	})
+}
+
+func infobloxSharedRecordTXT() *schema.Resource {
+	return infobloxSharedRecord(sharedRecordType{
+		objectType: "sharedrecord:txt",
+		recordType: "TXT",
+		valueField: "text",
+		fields: map[string]sharedRecordField{
+			"text": {"text", &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			}},
+		},
+	})
+}
+
+// infobloxSharedRecord returns the resource managing shared records of the
+// given type.
+func infobloxSharedRecord(t sharedRecordType) *schema.Resource {
+	s := map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateSharedRecordName,
+		},
+		"shared_record_group": &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"comment": &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "",
+		},
+		"ttl": &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validateTTL,
+		},
+		"extensible_attributes": extAttrsSchema(),
+	}
+	for name, field := range t.fields {
+		s[name] = field.schema
+	}
+
+	return &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceInfobloxSharedRecordCreate(d, meta, t)
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceInfobloxSharedRecordRead(d, meta, t)
+		},
+		Update: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceInfobloxSharedRecordUpdate(d, meta, t)
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceInfobloxSharedRecordDelete(d, meta, t)
+		},
+		Importer: &schema.ResourceImporter{
+			State: importInfobloxSharedRecord(t),
+		},
+
+		Schema: s,
+	}
+}
+
+// returnFields lists the fields we read back for shared records of type t.
+func (t sharedRecordType) returnFields() []string {
+	var fields []string
+	for _, field := range t.fields {
+		fields = append(fields, field.wapiField)
+	}
+	// Sort the fields so the generated URLs are stable.
+	sort.Strings(fields)
+
+	return append([]string{"name", "shared_record_group", "comment", "ttl", "use_ttl", "extattrs"}, fields...)
+}
+
+// sharedRecordName returns the name of a shared record as sent to WAPI,
+// which leaves it empty for the apex of the group's zones.
+func sharedRecordName(name string) string {
+	if name == "@" {
+		return ""
+	}
+	return name
+}
+
+// sharedRecordObjectFromAttributes builds the body of a sharedrecord create
+// or update request from the attributes as set by terraform.
+// The Infoblox WAPI does not allow the group of an existing shared record to
+// be changed, so we take an isUpdate arg to skip setting it.
+func sharedRecordObjectFromAttributes(d *schema.ResourceData, meta interface{}, t sharedRecordType, isUpdate bool) (map[string]interface{}, error) {
+	if t.validate != nil {
+		if err := t.validate(d); err != nil {
+			return nil, err
+		}
+	}
+
+	record := map[string]interface{}{
+		"name":    sharedRecordName(d.Get("name").(string)),
+		"comment": d.Get("comment").(string),
+	}
+	if !isUpdate {
+		record["shared_record_group"] = d.Get("shared_record_group").(string)
+	}
+
+	if attr, ok := d.GetOk("ttl"); ok {
+		record["ttl"] = attr.(int)
+		record["use_ttl"] = true
+	} else {
+		record["use_ttl"] = false
+	}
+
+	for name, field := range t.fields {
+		record[field.wapiField] = d.Get(name)
+	}
+
+	extAttrs, err := extAttrsFromResourceData(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	extAttrs.addTo(record)
+
+	return record, nil
+}
+
+// validateSharedRecordZones checks that the name of a new shared record does
+// not name one of the zones of its group, or a name inside one, which would
+// be taken relative to every zone of the group.
+func validateSharedRecordZones(client *infoblox.Client, d *schema.ResourceData) error {
+	name := d.Get("name").(string)
+	group := d.Get("shared_record_group").(string)
+
+	groups, err := wapiFind(client, "sharedrecordgroup", map[string]string{"name": group}, []string{"zone_associations"})
+	if err != nil {
+		return fmt.Errorf("error finding Infoblox shared record group %s: %s", group, err)
+	}
+	if len(groups) != 1 {
+		return fmt.Errorf("expected one Infoblox shared record group named %s, found %d", group, len(groups))
+	}
+
+	associations, _ := groups[0]["zone_associations"].([]interface{})
+	for _, v := range associations {
+		association, _ := v.(map[string]interface{})
+		zone, _ := association["fqdn"].(string)
+		if zone != "" && relativeRecordName(name, zone) != name {
+			return fmt.Errorf("shared record %s must be named relative to the zones of shared record group %s, but names a record in zone %s",
+				name, group, zone)
+		}
+	}
+	return nil
+}
+
+func resourceInfobloxSharedRecordCreate(d *schema.ResourceData, meta interface{}, t sharedRecordType) error {
+	client := meta.(*providerMeta).client
+
+	if err := validateSharedRecordZones(client, d); err != nil {
+		return err
+	}
+
+	record, err := sharedRecordObjectFromAttributes(d, meta, t, false)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating Infoblox shared %s record with configuration: %#v", t.recordType, record)
+
+	recordID, err := wapiCreate(client, t.objectType, record)
+	if err != nil {
+		return fmt.Errorf("error creating Infoblox shared %s record: %s", t.recordType, err.Error())
+	}
+
+	d.SetId(recordID)
+	log.Printf("[INFO] Infoblox shared %s record created with ID: %s", t.recordType, d.Id())
+
+	return resourceInfobloxSharedRecordRead(d, meta, t)
+}
+
+func resourceInfobloxSharedRecordRead(d *schema.ResourceData, meta interface{}, t sharedRecordType) error {
+	client := meta.(*providerMeta).client
+
+	record, err := wapiGet(client, d.Id(), t.returnFields())
+	if err != nil {
+		return handleReadError(d, "shared "+t.recordType, err)
+	}
+
+	name, _ := record["name"].(string)
+	if name == "" {
+		name = "@"
+	}
+	d.Set("name", name)
+	d.Set("shared_record_group", record["shared_record_group"])
+	readRecordProperties(d, meta, record)
+
+	for name, field := range t.fields {
+		value := record[field.wapiField]
+		if n, ok := wapiInt(value); ok && field.schema.Type == schema.TypeInt {
+			value = n
+		}
+		d.Set(name, value)
+	}
+
+	return nil
+}
+
+func resourceInfobloxSharedRecordUpdate(d *schema.ResourceData, meta interface{}, t sharedRecordType) error {
+	client := meta.(*providerMeta).client
+
+	record, err := sharedRecordObjectFromAttributes(d, meta, t, true)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Infoblox shared %s record with configuration: %#v", t.recordType, record)
+
+	recordID, err := wapiUpdate(client, d.Id(), record)
+	if err != nil {
+		return fmt.Errorf("error updating Infoblox shared %s record: %s", t.recordType, err.Error())
+	}
+
+	d.SetId(recordID)
+	log.Printf("[INFO] Infoblox shared %s record updated with ID: %s", t.recordType, d.Id())
+
+	return resourceInfobloxSharedRecordRead(d, meta, t)
+}
+
+func resourceInfobloxSharedRecordDelete(d *schema.ResourceData, meta interface{}, t sharedRecordType) error {
+	client := meta.(*providerMeta).client
+
+	log.Printf("[DEBUG] Deleting Infoblox shared %s record: %s, %s", t.recordType, d.Get("name").(string), d.Id())
+
+	err := wapiDelete(client, d.Id())
+	if err != nil && !isNotFoundError(err) {
+		return fmt.Errorf("error deleting Infoblox shared %s record: %s", t.recordType, err.Error())
+	}
+
+	return nil
+}
+
+// importInfobloxSharedRecord returns a schema.StateFunc which imports shared
+// records of the given type. Like importInfobloxRecord it accepts either the
+// WAPI object reference of the record or a human readable
+// "group/name/value" triple, where the value may be left off as long as the
+// group and name identify a single record.
+func importInfobloxSharedRecord(t sharedRecordType) schema.StateFunc {
+	return func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		if strings.HasPrefix(d.Id(), t.objectType+"/") {
+			return []*schema.ResourceData{d}, nil
+		}
+
+		parts := strings.SplitN(d.Id(), "/", 3)
+		if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf(
+				"invalid import ID %q: must be a WAPI object reference or of the form <group>/<name>[/<value>]", d.Id())
+		}
+
+		conditions := map[string]string{
+			"shared_record_group": parts[0],
+			"name":                sharedRecordName(parts[1]),
+		}
+		if len(parts) == 3 && parts[2] != "" {
+			conditions[t.valueField] = parts[2]
+		}
+
+		client := meta.(*providerMeta).client
+
+		records, err := wapiFind(client, t.objectType, conditions, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error finding Infoblox %s %s in group %s: %s", t.objectType, parts[1], parts[0], err)
+		}
+
+		switch len(records) {
+		case 0:
+			return nil, fmt.Errorf("no Infoblox %s %s found in group %s", t.objectType, parts[1], parts[0])
+		case 1:
+		default:
+			return nil, fmt.Errorf(
+				"%d Infoblox %s records named %s found in group %s, import using the object reference or <group>/<name>/<%s>",
+				len(records), t.objectType, parts[1], parts[0], t.valueField)
+		}
+
+		ref := records[0]["_ref"].(string)
+		log.Printf("[DEBUG] Resolved Infoblox %s import ID %q to %s", t.objectType, d.Id(), ref)
+		d.SetId(ref)
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
@@ -0,0 +1,222 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func infobloxSharedRecordGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInfobloxSharedRecordGroupCreate,
+		Read:   resourceInfobloxSharedRecordGroupRead,
+		Update: resourceInfobloxSharedRecordGroupUpdate,
+		Delete: resourceInfobloxSharedRecordGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: importInfobloxSharedRecordGroup,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"view": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"zones": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateZoneName,
+				},
+				Set: schema.HashString,
+			},
+			"comment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"extensible_attributes": extAttrsSchema(),
+		},
+	}
+}
+
+// sharedRecordGroupReturnFields lists the fields we read back for shared
+// record groups.
+var sharedRecordGroupReturnFields = []string{"name", "zone_associations", "comment", "extattrs"}
+
+// sharedRecordGroupObjectFromAttributes builds the body of a sharedrecordgroup
+// create or update request from the attributes as set by terraform. The
+// group is associated with its zones in the group's view, falling back to the
+// provider's default_view like setDefaultView does on create.
+func sharedRecordGroupObjectFromAttributes(d *schema.ResourceData, meta interface{}) (map[string]interface{}, error) {
+	view := d.Get("view").(string)
+	if view == "" {
+		view = meta.(*providerMeta).defaultView
+	}
+
+	zones := d.Get("zones").(*schema.Set).List()
+	associations := make([]map[string]interface{}, 0, len(zones))
+	for _, zone := range zones {
+		associations = append(associations, map[string]interface{}{
+			"fqdn": strings.TrimSuffix(zone.(string), "."),
+			"view": view,
+		})
+	}
+
+	group := map[string]interface{}{
+		"name":              d.Get("name").(string),
+		"zone_associations": associations,
+		"comment":           d.Get("comment").(string),
+	}
+
+	extAttrs, err := extAttrsFromResourceData(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	extAttrs.addTo(group)
+
+	return group, nil
+}
+
+// flattenZoneAssociations returns the zones of a shared record group, which
+// must all be in the given view. The resource only models zones of a single
+// view and updates replace every association, so zones of other views would
+// be silently dropped by the next update.
+func flattenZoneAssociations(associations interface{}, view string) ([]interface{}, error) {
+	var result []interface{}
+
+	list, _ := associations.([]interface{})
+	for _, v := range list {
+		association, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if associationView, _ := association["view"].(string); associationView != view {
+			return nil, fmt.Errorf("shared record group is associated with zone %v of view %s, but only zones of view %s can be managed",
+				association["fqdn"], associationView, view)
+		}
+		result = append(result, association["fqdn"])
+	}
+	return result, nil
+}
+
+func resourceInfobloxSharedRecordGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	setDefaultView(d, meta)
+	client := meta.(*providerMeta).client
+
+	group, err := sharedRecordGroupObjectFromAttributes(d, meta)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating Infoblox shared record group with configuration: %#v", group)
+
+	groupID, err := wapiCreate(client, "sharedrecordgroup", group)
+	if err != nil {
+		return fmt.Errorf("error creating Infoblox shared record group: %s", err.Error())
+	}
+
+	d.SetId(groupID)
+	log.Printf("[INFO] Infoblox shared record group created with ID: %s", d.Id())
+
+	return resourceInfobloxSharedRecordGroupRead(d, meta)
+}
+
+func resourceInfobloxSharedRecordGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	group, err := wapiGet(client, d.Id(), sharedRecordGroupReturnFields)
+	if err != nil {
+		return handleReadError(d, "shared record group", err)
+	}
+
+	// Imported groups have no view yet, so take the view of their first
+	// zone, falling back to the provider's default_view.
+	view := d.Get("view").(string)
+	if view == "" {
+		view = meta.(*providerMeta).defaultView
+		if associations, ok := group["zone_associations"].([]interface{}); ok && len(associations) > 0 {
+			if association, ok := associations[0].(map[string]interface{}); ok {
+				if v, ok := association["view"].(string); ok && v != "" {
+					view = v
+				}
+			}
+		}
+	}
+
+	zones, err := flattenZoneAssociations(group["zone_associations"], view)
+	if err != nil {
+		return fmt.Errorf("error reading Infoblox shared record group %s: %s", d.Id(), err)
+	}
+
+	d.Set("name", group["name"])
+	d.Set("view", view)
+	d.Set("zones", zones)
+	d.Set("comment", group["comment"])
+	d.Set("extensible_attributes", flattenExtAttrs(d, meta, group["extattrs"]))
+
+	return nil
+}
+
+func resourceInfobloxSharedRecordGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	group, err := sharedRecordGroupObjectFromAttributes(d, meta)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Infoblox shared record group with configuration: %#v", group)
+
+	groupID, err := wapiUpdate(client, d.Id(), group)
+	if err != nil {
+		return fmt.Errorf("error updating Infoblox shared record group: %s", err.Error())
+	}
+
+	d.SetId(groupID)
+	log.Printf("[INFO] Infoblox shared record group updated with ID: %s", d.Id())
+
+	return resourceInfobloxSharedRecordGroupRead(d, meta)
+}
+
+func resourceInfobloxSharedRecordGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	log.Printf("[DEBUG] Deleting Infoblox shared record group: %s, %s", d.Get("name").(string), d.Id())
+
+	err := wapiDelete(client, d.Id())
+	if err != nil && !isNotFoundError(err) {
+		return fmt.Errorf("error deleting Infoblox shared record group: %s", err.Error())
+	}
+
+	return nil
+}
+
+// importInfobloxSharedRecordGroup accepts either the WAPI object reference of
+// a shared record group or its name.
+func importInfobloxSharedRecordGroup(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if strings.HasPrefix(d.Id(), "sharedrecordgroup/") {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	client := meta.(*providerMeta).client
+
+	groups, err := wapiFind(client, "sharedrecordgroup", map[string]string{"name": d.Id()}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error finding Infoblox shared record group %s: %s", d.Id(), err)
+	}
+	if len(groups) != 1 {
+		return nil, fmt.Errorf("expected one Infoblox shared record group named %s, found %d", d.Id(), len(groups))
+	}
+
+	d.SetId(groups[0]["_ref"].(string))
+
+	return []*schema.ResourceData{d}, nil
+}
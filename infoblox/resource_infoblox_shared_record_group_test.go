@@ -0,0 +1,60 @@
+package infoblox
+
+import (
+	"testing"
+)
+
+func TestMockInfobloxSharedRecordGroupImport(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	ref := m.add("sharedrecordgroup", map[string]interface{}{
+		"name": "mail",
+		"zone_associations": []interface{}{
+			map[string]interface{}{"fqdn": "example.com", "view": "internal"},
+		},
+	})
+
+	r := infobloxSharedRecordGroup()
+	d := r.TestResourceData()
+	d.SetId("mail")
+
+	imported, err := r.Importer.State(d, m.meta())
+	if err != nil {
+		t.Fatalf("error importing sharedrecordgroup: %s", err)
+	}
+	if imported[0].Id() != ref {
+		t.Fatalf("expected import to resolve to %s, got %s", ref, imported[0].Id())
+	}
+
+	if err := r.Read(imported[0], m.meta()); err != nil {
+		t.Fatalf("error reading sharedrecordgroup: %s", err)
+	}
+	testMockCheckAttributes(t, imported[0], map[string]string{
+		"view":    "internal",
+		"zones.#": "1",
+	})
+}
+
+// Updates replace every zone association of a group, so groups with zones in
+// several views must not be read as if they only had those of one.
+func TestMockInfobloxSharedRecordGroup_views(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	ref := m.add("sharedrecordgroup", map[string]interface{}{
+		"name": "mail",
+		"zone_associations": []interface{}{
+			map[string]interface{}{"fqdn": "example.com", "view": "internal"},
+			map[string]interface{}{"fqdn": "example.com", "view": "external"},
+		},
+	})
+
+	r := infobloxSharedRecordGroup()
+	d := r.TestResourceData()
+	d.SetId(ref)
+
+	if err := r.Read(d, m.meta()); err == nil {
+		t.Fatal("expected an error reading a sharedrecordgroup with zones in several views")
+	}
+}
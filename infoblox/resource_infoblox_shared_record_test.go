@@ -0,0 +1,95 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// testMockSharedRecordGroup adds a shared record group associated with the
+// example.com and example.org zones.
+func testMockSharedRecordGroup(m *mockWAPI, name string) {
+	m.add("sharedrecordgroup", map[string]interface{}{
+		"name": name,
+		"zone_associations": []interface{}{
+			map[string]interface{}{"fqdn": "example.com", "view": "default"},
+			map[string]interface{}{"fqdn": "example.org", "view": "default"},
+		},
+	})
+}
+
+func TestMockInfobloxSharedRecordCNAME_apex(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+	testMockSharedRecordGroup(m, "web")
+
+	r := infobloxSharedRecordCNAME()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":                "@",
+		"shared_record_group": "web",
+		"canonical":           "lb.example.com",
+	})
+	if err := r.Create(d, m.meta()); err == nil {
+		t.Fatal("expected an error creating a shared CNAME record at the apex")
+	}
+	if n := m.count("sharedrecord:cname"); n != 0 {
+		t.Fatalf("expected no shared CNAME record to be created, found %d", n)
+	}
+}
+
+// Shared record names are relative to the zones of their group, so a name
+// inside one of them is a mistake.
+func TestMockInfobloxSharedRecordA_zoneName(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+	testMockSharedRecordGroup(m, "web")
+
+	r := infobloxSharedRecordA()
+	for _, name := range []string{"example.com", "www.example.org"} {
+		d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+			"name":                name,
+			"shared_record_group": "web",
+			"address":             "10.0.0.10",
+		})
+		if err := r.Create(d, m.meta()); err == nil {
+			t.Fatalf("expected an error creating a shared A record named %s", name)
+		}
+	}
+	if n := m.count("sharedrecord:a"); n != 0 {
+		t.Fatalf("expected no shared A record to be created, found %d", n)
+	}
+}
+
+func TestMockInfobloxSharedRecordMXImport(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	ref := m.add("sharedrecord:mx", map[string]interface{}{
+		"name":                "",
+		"shared_record_group": "mail",
+		"mail_exchanger":      "mx1.example.com",
+	})
+	m.add("sharedrecord:mx", map[string]interface{}{
+		"name":                "",
+		"shared_record_group": "mail",
+		"mail_exchanger":      "mx2.example.com",
+	})
+
+	r := infobloxSharedRecordMX()
+	d := r.TestResourceData()
+	d.SetId("mail/@/mx1.example.com")
+
+	imported, err := r.Importer.State(d, m.meta())
+	if err != nil {
+		t.Fatalf("error importing sharedrecord:mx: %s", err)
+	}
+	if imported[0].Id() != ref {
+		t.Fatalf("expected import to resolve to %s, got %s", ref, imported[0].Id())
+	}
+
+	d = r.TestResourceData()
+	d.SetId("mail/@")
+	if _, err := r.Importer.State(d, m.meta()); err == nil {
+		t.Fatal("expected an error importing one of several sharedrecord:mx without an exchanger")
+	}
+}
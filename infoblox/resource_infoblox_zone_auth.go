@@ -0,0 +1,357 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// zoneMemberSchema represents the schema for a grid member serving a zone
+func zoneMemberSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateFQDN,
+		},
+		"stealth": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"grid_replicate": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"lead": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+	}
+}
+
+func infobloxZoneAuth() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInfobloxZoneAuthCreate,
+		Read:   resourceInfobloxZoneAuthRead,
+		Update: resourceInfobloxZoneAuthUpdate,
+		Delete: resourceInfobloxZoneAuthDelete,
+		Importer: &schema.ResourceImporter{
+			State: importInfobloxZoneAuth,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"fqdn": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateZoneName,
+			},
+			"zone_format": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "FORWARD",
+			},
+			"view": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"ns_group": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"grid_primary", "grid_secondary"},
+			},
+			"grid_primary": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Resource{Schema: zoneMemberSchema()},
+			},
+			"grid_secondary": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Resource{Schema: zoneMemberSchema()},
+			},
+			"soa_default_ttl": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateTTL,
+			},
+			"soa_expire": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateTTL,
+			},
+			"soa_negative_ttl": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateTTL,
+			},
+			"soa_refresh": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateTTL,
+			},
+			"soa_retry": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateTTL,
+			},
+			"soa_email": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"comment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"extensible_attributes": extAttrsSchema(),
+		},
+	}
+}
+
+// zoneAuthReturnFields lists the fields we read back for authoritative zones.
+var zoneAuthReturnFields = []string{
+	"fqdn", "zone_format", "view", "ns_group", "grid_primary", "grid_secondaries",
+	"soa_default_ttl", "soa_expire", "soa_negative_ttl", "soa_refresh", "soa_retry", "soa_email",
+	"comment", "extattrs",
+}
+
+// zoneSOATimers are the SOA timer arguments which are only honoured by WAPI
+// when the zone overrides the grid wide zone timers.
+var zoneSOATimers = []string{"soa_default_ttl", "soa_expire", "soa_negative_ttl", "soa_refresh", "soa_retry"}
+
+// Validates that the zone format is known and that reverse zones are given
+// as a network of the matching address family.
+func validateZoneAuthData(d *schema.ResourceData) error {
+	fqdn := d.Get("fqdn").(string)
+
+	switch format := d.Get("zone_format").(string); format {
+	case "FORWARD":
+	case "IPV4", "IPV6":
+		ip, _, err := net.ParseCIDR(fqdn)
+		if err != nil {
+			return fmt.Errorf("the fqdn of an %s zone must be a network in CIDR notation, got %q", format, fqdn)
+		}
+		if (ip.To4() != nil) != (format == "IPV4") {
+			return fmt.Errorf("network %q does not match zone_format %s", fqdn, format)
+		}
+	default:
+		return fmt.Errorf("zone_format must be one of FORWARD, IPV4 or IPV6, got %q", format)
+	}
+	return nil
+}
+
+func zoneMembersFromList(members []interface{}) []map[string]interface{} {
+	// WAPI rejects null lists, so always send at least an empty one.
+	result := make([]map[string]interface{}, 0, len(members))
+
+	for _, v := range members {
+		memberMap := v.(map[string]interface{})
+		result = append(result, map[string]interface{}{
+			"name":           memberMap["name"].(string),
+			"stealth":        memberMap["stealth"].(bool),
+			"grid_replicate": memberMap["grid_replicate"].(bool),
+			"lead":           memberMap["lead"].(bool),
+		})
+	}
+	return result
+}
+
+func flattenZoneMembers(members interface{}) []interface{} {
+	var result []interface{}
+
+	list, _ := members.([]interface{})
+	for _, v := range list {
+		member, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		m := map[string]interface{}{"name": member["name"]}
+		for _, flag := range []string{"stealth", "grid_replicate", "lead"} {
+			if val, ok := member[flag].(bool); ok {
+				m[flag] = val
+			}
+		}
+		result = append(result, m)
+	}
+	return result
+}
+
+// zoneAuthObjectFromAttributes builds the body of a zone_auth create or update
+// request from the attributes as set by terraform.
+// The Infoblox WAPI does not allow the fqdn, zone_format or view of an existing
+// zone to be changed, so we take an isUpdate arg to skip setting them.
+func zoneAuthObjectFromAttributes(d *schema.ResourceData, meta interface{}, isUpdate bool) (map[string]interface{}, error) {
+	zone := make(map[string]interface{})
+
+	if !isUpdate {
+		zone["fqdn"] = d.Get("fqdn").(string)
+		zone["zone_format"] = d.Get("zone_format").(string)
+		zone["view"] = d.Get("view").(string)
+	}
+
+	zone["comment"] = d.Get("comment").(string)
+
+	if attr, ok := d.GetOk("ns_group"); ok {
+		zone["ns_group"] = attr.(string)
+	} else {
+		zone["grid_primary"] = zoneMembersFromList(d.Get("grid_primary").([]interface{}))
+		zone["grid_secondaries"] = zoneMembersFromList(d.Get("grid_secondary").([]interface{}))
+	}
+
+	useTimers := false
+	for _, timer := range zoneSOATimers {
+		if attr, ok := d.GetOk(timer); ok {
+			zone[timer] = attr.(int)
+			useTimers = true
+		}
+	}
+	zone["use_grid_zone_timer"] = useTimers
+
+	if attr, ok := d.GetOk("soa_email"); ok {
+		zone["soa_email"] = attr.(string)
+		zone["use_soa_email"] = true
+	}
+
+	extAttrs, err := extAttrsFromResourceData(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	extAttrs.addTo(zone)
+
+	return zone, nil
+}
+
+func resourceInfobloxZoneAuthCreate(d *schema.ResourceData, meta interface{}) error {
+	if err := validateZoneAuthData(d); err != nil {
+		return err
+	}
+
+	setDefaultView(d, meta)
+	client := meta.(*providerMeta).client
+
+	zone, err := zoneAuthObjectFromAttributes(d, meta, false)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating Infoblox zone_auth with configuration: %#v", zone)
+
+	zoneID, err := wapiCreate(client, "zone_auth", zone)
+	if err != nil {
+		return fmt.Errorf("error creating Infoblox zone_auth: %s", err.Error())
+	}
+
+	d.SetId(zoneID)
+	log.Printf("[INFO] Infoblox zone_auth created with ID: %s", d.Id())
+
+	return resourceInfobloxZoneAuthRead(d, meta)
+}
+
+func resourceInfobloxZoneAuthRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	zone, err := wapiGet(client, d.Id(), zoneAuthReturnFields)
+	if err != nil {
+		return handleReadError(d, "zone_auth", err)
+	}
+
+	d.Set("fqdn", zone["fqdn"])
+	d.Set("zone_format", zone["zone_format"])
+	d.Set("view", zone["view"])
+	d.Set("ns_group", zone["ns_group"])
+	d.Set("comment", zone["comment"])
+	d.Set("soa_email", zone["soa_email"])
+	d.Set("extensible_attributes", flattenExtAttrs(d, meta, zone["extattrs"]))
+
+	// Members are implied by the name server group when one is used.
+	if _, ok := d.GetOk("ns_group"); !ok {
+		d.Set("grid_primary", flattenZoneMembers(zone["grid_primary"]))
+		d.Set("grid_secondary", flattenZoneMembers(zone["grid_secondaries"]))
+	}
+
+	for _, timer := range zoneSOATimers {
+		if val, ok := wapiInt(zone[timer]); ok {
+			d.Set(timer, val)
+		}
+	}
+
+	return nil
+}
+
+func resourceInfobloxZoneAuthUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	zone, err := zoneAuthObjectFromAttributes(d, meta, true)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Infoblox zone_auth with configuration: %#v", zone)
+
+	zoneID, err := wapiUpdate(client, d.Id(), zone)
+	if err != nil {
+		return fmt.Errorf("error updating Infoblox zone_auth: %s", err.Error())
+	}
+
+	d.SetId(zoneID)
+	log.Printf("[INFO] Infoblox zone_auth updated with ID: %s", d.Id())
+
+	return resourceInfobloxZoneAuthRead(d, meta)
+}
+
+func resourceInfobloxZoneAuthDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	log.Printf("[DEBUG] Deleting Infoblox zone_auth: %s, %s", d.Get("fqdn").(string), d.Id())
+
+	err := wapiDelete(client, d.Id())
+	if err != nil && !isNotFoundError(err) {
+		return fmt.Errorf("error deleting Infoblox zone_auth: %s", err.Error())
+	}
+
+	return nil
+}
+
+// importInfobloxZoneAuth accepts either the WAPI object reference of a zone or
+// a "<view>/<fqdn>" ID such as "default/example.com" or "default/10.0.0.0/24".
+func importInfobloxZoneAuth(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if strings.HasPrefix(d.Id(), "zone_auth/") {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	client := meta.(*providerMeta).client
+
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf(
+			"invalid import ID %q: must be a WAPI object reference or of the form <view>/<fqdn>", d.Id())
+	}
+
+	zones, err := wapiFind(client, "zone_auth", map[string]string{
+		"view": parts[0],
+		"fqdn": parts[1],
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error finding Infoblox zone_auth %s: %s", d.Id(), err)
+	}
+	if len(zones) != 1 {
+		return nil, fmt.Errorf("expected one Infoblox zone_auth matching %s, found %d", d.Id(), len(zones))
+	}
+
+	d.SetId(zones[0]["_ref"].(string))
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,57 @@
+package infoblox
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestMockInfobloxZoneAuth_reverse(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	testMockLifecycle(t, m, mockLifecycleTest{
+		Resource:   infobloxZoneAuth(),
+		ObjectType: "zone_auth",
+		Create: map[string]interface{}{
+			"fqdn":        "10.0.0.0/24",
+			"zone_format": "IPV4",
+			"ns_group":    "default-ns",
+		},
+		CreateCheck: map[string]string{
+			"fqdn":     "10.0.0.0/24",
+			"ns_group": "default-ns",
+		},
+	})
+
+	r := infobloxZoneAuth()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"fqdn":        "2001:db8::/48",
+		"zone_format": "IPV4",
+	})
+	if err := r.Create(d, m.meta()); err == nil {
+		t.Fatalf("expected an error creating an IPV4 zone for an IPv6 network")
+	}
+}
+
+func TestMockInfobloxZoneAuthImport(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	ref := m.add("zone_auth", map[string]interface{}{
+		"fqdn": "10.0.0.0/24",
+		"view": "default",
+	})
+
+	r := infobloxZoneAuth()
+	d := r.TestResourceData()
+	d.SetId("default/10.0.0.0/24")
+
+	imported, err := r.Importer.State(d, m.meta())
+	if err != nil {
+		t.Fatalf("error importing zone_auth: %s", err)
+	}
+	if imported[0].Id() != ref {
+		t.Fatalf("expected import to resolve to %s, got %s", ref, imported[0].Id())
+	}
+}
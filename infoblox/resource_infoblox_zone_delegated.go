@@ -0,0 +1,241 @@
+package infoblox
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// delegateToSchema represents the schema for a name server a zone is
+// delegated to
+func delegateToSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateFQDN,
+		},
+		"address": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateIPAddress,
+		},
+	}
+}
+
+func infobloxZoneDelegated() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInfobloxZoneDelegatedCreate,
+		Read:   resourceInfobloxZoneDelegatedRead,
+		Update: resourceInfobloxZoneDelegatedUpdate,
+		Delete: resourceInfobloxZoneDelegatedDelete,
+		Importer: &schema.ResourceImporter{
+			State: importInfobloxZoneDelegated,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"fqdn": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateZoneName,
+			},
+			"view": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"delegate_to": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Resource{Schema: delegateToSchema()},
+			},
+			"delegated_ttl": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateTTL,
+			},
+			"comment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"extensible_attributes": extAttrsSchema(),
+		},
+	}
+}
+
+// zoneDelegatedReturnFields lists the fields we read back for delegated zones.
+var zoneDelegatedReturnFields = []string{
+	"fqdn", "view", "delegate_to", "delegated_ttl", "use_delegated_ttl", "comment", "extattrs",
+}
+
+func delegateToFromList(servers []interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(servers))
+
+	for _, v := range servers {
+		serverMap := v.(map[string]interface{})
+		result = append(result, map[string]interface{}{
+			"name":    serverMap["name"].(string),
+			"address": serverMap["address"].(string),
+		})
+	}
+	return result
+}
+
+func flattenDelegateTo(servers interface{}) []interface{} {
+	var result []interface{}
+
+	list, _ := servers.([]interface{})
+	for _, v := range list {
+		server, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"name":    server["name"],
+			"address": server["address"],
+		})
+	}
+	return result
+}
+
+// zoneDelegatedObjectFromAttributes builds the body of a zone_delegated create
+// or update request from the attributes as set by terraform.
+// The Infoblox WAPI does not allow the fqdn or view of an existing zone to be
+// changed, so we take an isUpdate arg to skip setting them.
+func zoneDelegatedObjectFromAttributes(d *schema.ResourceData, meta interface{}, isUpdate bool) (map[string]interface{}, error) {
+	zone := make(map[string]interface{})
+
+	if !isUpdate {
+		zone["fqdn"] = d.Get("fqdn").(string)
+		zone["view"] = d.Get("view").(string)
+	}
+
+	zone["delegate_to"] = delegateToFromList(d.Get("delegate_to").([]interface{}))
+	zone["comment"] = d.Get("comment").(string)
+
+	if attr, ok := d.GetOk("delegated_ttl"); ok {
+		zone["delegated_ttl"] = attr.(int)
+		zone["use_delegated_ttl"] = true
+	} else {
+		zone["use_delegated_ttl"] = false
+	}
+
+	extAttrs, err := extAttrsFromResourceData(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	extAttrs.addTo(zone)
+
+	return zone, nil
+}
+
+func resourceInfobloxZoneDelegatedCreate(d *schema.ResourceData, meta interface{}) error {
+	setDefaultView(d, meta)
+	client := meta.(*providerMeta).client
+
+	zone, err := zoneDelegatedObjectFromAttributes(d, meta, false)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating Infoblox zone_delegated with configuration: %#v", zone)
+
+	zoneID, err := wapiCreate(client, "zone_delegated", zone)
+	if err != nil {
+		return fmt.Errorf("error creating Infoblox zone_delegated: %s", err.Error())
+	}
+
+	d.SetId(zoneID)
+	log.Printf("[INFO] Infoblox zone_delegated created with ID: %s", d.Id())
+
+	return resourceInfobloxZoneDelegatedRead(d, meta)
+}
+
+func resourceInfobloxZoneDelegatedRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	zone, err := wapiGet(client, d.Id(), zoneDelegatedReturnFields)
+	if err != nil {
+		return handleReadError(d, "zone_delegated", err)
+	}
+
+	d.Set("fqdn", zone["fqdn"])
+	d.Set("view", zone["view"])
+	d.Set("delegate_to", flattenDelegateTo(zone["delegate_to"]))
+	d.Set("comment", zone["comment"])
+	d.Set("extensible_attributes", flattenExtAttrs(d, meta, zone["extattrs"]))
+
+	d.Set("delegated_ttl", wapiTTL(zone, "delegated_ttl", "use_delegated_ttl"))
+
+	return nil
+}
+
+func resourceInfobloxZoneDelegatedUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	zone, err := zoneDelegatedObjectFromAttributes(d, meta, true)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Infoblox zone_delegated with configuration: %#v", zone)
+
+	zoneID, err := wapiUpdate(client, d.Id(), zone)
+	if err != nil {
+		return fmt.Errorf("error updating Infoblox zone_delegated: %s", err.Error())
+	}
+
+	d.SetId(zoneID)
+	log.Printf("[INFO] Infoblox zone_delegated updated with ID: %s", d.Id())
+
+	return resourceInfobloxZoneDelegatedRead(d, meta)
+}
+
+func resourceInfobloxZoneDelegatedDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*providerMeta).client
+
+	log.Printf("[DEBUG] Deleting Infoblox zone_delegated: %s, %s", d.Get("fqdn").(string), d.Id())
+
+	err := wapiDelete(client, d.Id())
+	if err != nil && !isNotFoundError(err) {
+		return fmt.Errorf("error deleting Infoblox zone_delegated: %s", err.Error())
+	}
+
+	return nil
+}
+
+// importInfobloxZoneDelegated accepts either the WAPI object reference of a
+// delegated zone or a "<view>/<fqdn>" ID such as "default/aws.example.com".
+func importInfobloxZoneDelegated(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if strings.HasPrefix(d.Id(), "zone_delegated/") {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	client := meta.(*providerMeta).client
+
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf(
+			"invalid import ID %q: must be a WAPI object reference or of the form <view>/<fqdn>", d.Id())
+	}
+
+	zones, err := wapiFind(client, "zone_delegated", map[string]string{
+		"view": parts[0],
+		"fqdn": parts[1],
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error finding Infoblox zone_delegated %s: %s", d.Id(), err)
+	}
+	if len(zones) != 1 {
+		return nil, fmt.Errorf("expected one Infoblox zone_delegated matching %s, found %d", d.Id(), len(zones))
+	}
+
+	d.SetId(zones[0]["_ref"].(string))
+
+	return []*schema.ResourceData{d}, nil
+}
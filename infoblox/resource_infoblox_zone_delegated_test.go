@@ -0,0 +1,27 @@
+package infoblox
+
+import (
+	"testing"
+)
+
+func TestMockInfobloxZoneDelegatedImport(t *testing.T) {
+	m := newMockWAPI(t)
+	defer m.Close()
+
+	ref := m.add("zone_delegated", map[string]interface{}{
+		"fqdn": "aws.example.com",
+		"view": "default",
+	})
+
+	r := infobloxZoneDelegated()
+	d := r.TestResourceData()
+	d.SetId("default/aws.example.com")
+
+	imported, err := r.Importer.State(d, m.meta())
+	if err != nil {
+		t.Fatalf("error importing zone_delegated: %s", err)
+	}
+	if imported[0].Id() != ref {
+		t.Fatalf("expected import to resolve to %s, got %s", ref, imported[0].Id())
+	}
+}
@@ -0,0 +1,199 @@
+package infoblox
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"math/rand"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Grid members restarting services, load balancers in front of the grid
+// master and the database lock WAPI takes while it applies changes all cause
+// requests to fail in ways that succeed when simply tried again. retryTransport
+// wraps the transport of the go-infoblox HTTP client so requests, whether sent
+// by go-infoblox itself or by the helpers in wapi.go, are retried with
+// exponential backoff when they fail with such a transient error.
+//
+// Only idempotent requests are retried after the grid may have seen them. A
+// POST creates an object or calls a function such as next_available_ip, and
+// when a proxy times out after the grid committed it, sending it again would
+// create a duplicate or allocate a second address. POSTs are therefore only
+// retried when the connection to the grid could not even be established.
+
+// retryableStatusCodes are HTTP status codes of transient failures.
+var retryableStatusCodes = map[int]bool{
+	http.StatusTooManyRequests:    true,
+	http.StatusBadGateway:         true,
+	http.StatusServiceUnavailable: true,
+	http.StatusGatewayTimeout:     true,
+}
+
+// idempotentMethods are the HTTP methods which can safely be sent again.
+var idempotentMethods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodOptions: true,
+	http.MethodPut:     true,
+	http.MethodDelete:  true,
+}
+
+// retryableWAPIMessages are fragments of WAPI error messages which indicate a
+// transient condition on the grid rather than a problem with the request.
+var retryableWAPIMessages = []string{
+	"is being updated",
+	"try again later",
+	"database is locked",
+	"is locked",
+}
+
+type retryTransport struct {
+	base       http.RoundTripper
+	maxRetries int
+	minBackoff time.Duration
+	maxBackoff time.Duration
+
+	// sleep waits between attempts; tests replace it to run without delays.
+	sleep func(time.Duration)
+}
+
+func newRetryTransport(base http.RoundTripper, maxRetries int, minBackoff, maxBackoff time.Duration) *retryTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &retryTransport{
+		base:       base,
+		maxRetries: maxRetries,
+		minBackoff: minBackoff,
+		maxBackoff: maxBackoff,
+		sleep:      time.Sleep,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		r := req
+		if attempt > 0 && req.Body != nil {
+			// The body of the previous attempt has been consumed, so send
+			// a fresh copy of it without modifying the caller's request.
+			if req.GetBody == nil {
+				return nil, fmt.Errorf("unable to retry %s %s: request body cannot be replayed", req.Method, req.URL.Path)
+			}
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			r = new(http.Request)
+			*r = *req
+			r.Body = body
+		}
+
+		resp, err := t.base.RoundTrip(r)
+
+		var reason string
+		if idempotentMethods[req.Method] {
+			reason = retryReason(resp, err)
+		} else if isDialError(err) {
+			reason = err.Error()
+		}
+		if reason == "" || attempt >= t.maxRetries {
+			return resp, err
+		}
+		if resp != nil {
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		wait := t.backoff(attempt)
+		log.Printf("[WARN] WAPI %s %s failed with %s, retrying in %s (retry %d of %d)",
+			req.Method, req.URL.Path, reason, wait, attempt+1, t.maxRetries)
+		t.sleep(wait)
+	}
+}
+
+// backoff returns how long to wait before the given retry: minBackoff doubled
+// for every previous retry, capped at maxBackoff, with some jitter so that
+// parallel resources do not all retry at the same moment. A minBackoff of 0
+// retries right away.
+func (t *retryTransport) backoff(attempt int) time.Duration {
+	if t.minBackoff <= 0 {
+		return 0
+	}
+
+	wait := t.minBackoff << uint(attempt)
+	// A negative wait means the shift overflowed.
+	if wait < 0 || wait > t.maxBackoff {
+		wait = t.maxBackoff
+	}
+	if jitter := int64(wait / 4); jitter > 0 {
+		wait += time.Duration(rand.Int63n(jitter))
+	}
+	return wait
+}
+
+// isDialError reports whether err is a failure to connect to the grid, in
+// which case the request cannot have reached it.
+func isDialError(err error) bool {
+	opErr, ok := err.(*net.OpError)
+	return ok && opErr.Op == "dial"
+}
+
+// retryReason classifies the outcome of a request. It returns a description
+// of the failure if the request should be retried, and "" otherwise.
+func retryReason(resp *http.Response, err error) string {
+	if err != nil {
+		// The grid master is unreachable, e.g. while it restarts.
+		return err.Error()
+	}
+	if retryableStatusCodes[resp.StatusCode] {
+		return resp.Status
+	}
+	if resp.StatusCode < 400 {
+		return ""
+	}
+
+	// Read the WAPI error and put the body back for the caller.
+	data, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(data))
+	if err != nil {
+		return ""
+	}
+
+	var wapiErr struct {
+		Error string `json:"Error"`
+		Code  string `json:"code"`
+		Text  string `json:"text"`
+	}
+	if json.Unmarshal(data, &wapiErr) != nil {
+		return ""
+	}
+
+	if isRetryableWAPIError(wapiErr.Code, wapiErr.Error+" "+wapiErr.Text) {
+		return wapiErr.Code + ": " + wapiErr.Text
+	}
+	return ""
+}
+
+// isRetryableWAPIError reports whether a WAPI error with the given code and
+// message is transient. Errors in the Server category are failures on the grid
+// itself; Client errors are only transient if they report a locked database.
+func isRetryableWAPIError(code, message string) bool {
+	if strings.HasPrefix(code, "Server.") {
+		return true
+	}
+
+	message = strings.ToLower(message)
+	for _, fragment := range retryableWAPIMessages {
+		if strings.Contains(message, fragment) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,151 @@
+package infoblox
+
+import (
+	"errors"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testRetryServer answers the first len(failures) requests with the given
+// status codes and bodies and every later request with 200 OK. It records the
+// body of each request it receives.
+func testRetryServer(failures []int, body string) (*httptest.Server, *[]string) {
+	var received []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		received = append(received, string(data))
+
+		if len(received) <= len(failures) {
+			w.WriteHeader(failures[len(received)-1])
+			w.Write([]byte(body))
+			return
+		}
+		w.Write([]byte(`"record:a/ZG5zLmJpbmRfYSQ:web.example.com/default"`))
+	}))
+	return server, &received
+}
+
+func testRetryClient(maxRetries int) *http.Client {
+	t := newRetryTransport(nil, maxRetries, time.Second, 30*time.Second)
+	t.sleep = func(time.Duration) {}
+	return &http.Client{Transport: t}
+}
+
+func TestRetryTransport(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Method   string
+		Failures []int
+		Body     string
+		Retries  int
+		Status   int
+		Requests int
+	}{
+		{"success", "PUT", nil, "", 3, http.StatusOK, 1},
+		{"bad gateway", "PUT", []int{502, 503}, "", 3, http.StatusOK, 3},
+		{"retries exhausted", "PUT", []int{502, 502, 502}, "", 2, http.StatusBadGateway, 3},
+		{"locked", "PUT", []int{400}, `{"Error": "AdmConDataError", "code": "Client.Ibap.Data", "text": "The database is locked, try again later"}`, 3, http.StatusOK, 2},
+		{"server error", "PUT", []int{500}, `{"Error": "AdmConError", "code": "Server.Ibap.Internal", "text": "Internal error"}`, 3, http.StatusOK, 2},
+		{"not found", "PUT", []int{404}, `{"Error": "AdmConDataNotFoundError", "code": "Client.Ibap.Data.NotFound", "text": "Reference not found"}`, 3, http.StatusNotFound, 1},
+		{"conflict", "PUT", []int{400}, `{"Error": "AdmConDataError", "code": "Client.Ibap.Data.Conflict", "text": "The record already exists"}`, 3, http.StatusBadRequest, 1},
+		{"create", "POST", nil, "", 3, http.StatusOK, 1},
+		{"create behind bad gateway", "POST", []int{502}, "", 3, http.StatusBadGateway, 1},
+		{"create locked", "POST", []int{400}, `{"Error": "AdmConDataError", "code": "Client.Ibap.Data", "text": "The database is locked, try again later"}`, 3, http.StatusBadRequest, 1},
+		{"get", "GET", []int{504}, "", 3, http.StatusOK, 2},
+		{"delete", "DELETE", []int{503}, "", 3, http.StatusOK, 2},
+	}
+
+	for _, tc := range cases {
+		server, received := testRetryServer(tc.Failures, tc.Body)
+
+		req, _ := http.NewRequest(tc.Method, server.URL, strings.NewReader(`{"name": "web.example.com"}`))
+		resp, err := testRetryClient(tc.Retries).Do(req)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.Name, err)
+		}
+		data, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		server.Close()
+
+		if resp.StatusCode != tc.Status {
+			t.Fatalf("%s: expected status %d, got %d", tc.Name, tc.Status, resp.StatusCode)
+		}
+		if len(*received) != tc.Requests {
+			t.Fatalf("%s: expected %d requests, got %d", tc.Name, tc.Requests, len(*received))
+		}
+		for _, body := range *received {
+			if body != `{"name": "web.example.com"}` {
+				t.Fatalf("%s: request body was not replayed, got %q", tc.Name, body)
+			}
+		}
+		// Callers still see the WAPI error of the final attempt.
+		if resp.StatusCode >= 400 && string(data) != tc.Body {
+			t.Fatalf("%s: expected body %q, got %q", tc.Name, tc.Body, string(data))
+		}
+	}
+}
+
+// testDialFailures fails the first failures requests as if the grid refused
+// the connection and answers every later request with 201 Created.
+type testDialFailures struct {
+	failures int
+	requests int
+}
+
+func (f *testDialFailures) RoundTrip(req *http.Request) (*http.Response, error) {
+	f.requests++
+	if f.requests <= f.failures {
+		return nil, &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+	}
+	return &http.Response{
+		StatusCode: http.StatusCreated,
+		Body:       ioutil.NopCloser(strings.NewReader(`"record:a/ZG5zLmJpbmRfYSQ:web.example.com/default"`)),
+		Request:    req,
+	}, nil
+}
+
+func TestRetryTransportDialError(t *testing.T) {
+	base := &testDialFailures{failures: 2}
+	tr := newRetryTransport(base, 3, time.Second, 30*time.Second)
+	tr.sleep = func(time.Duration) {}
+
+	req, _ := http.NewRequest("POST", "https://grid.example.com/wapi/v2.3/record:a", strings.NewReader(`{"name": "web.example.com"}`))
+	resp, err := (&http.Client{Transport: tr}).Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d", http.StatusCreated, resp.StatusCode)
+	}
+	if base.requests != 3 {
+		t.Fatalf("expected a POST which could not connect to be retried, got %d requests", base.requests)
+	}
+}
+
+func TestRetryTransportBackoff(t *testing.T) {
+	tr := newRetryTransport(nil, 10, time.Second, 30*time.Second)
+
+	for attempt, min := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 16 * time.Second, 30 * time.Second, 30 * time.Second} {
+		wait := tr.backoff(attempt)
+		if wait < min || wait > min+min/4 {
+			t.Fatalf("expected backoff of retry %d to be between %s and %s, got %s", attempt+1, min, min+min/4, wait)
+		}
+	}
+}
+
+func TestRetryTransportBackoffWithoutMinimum(t *testing.T) {
+	tr := newRetryTransport(nil, 10, 0, 30*time.Second)
+
+	for attempt := 0; attempt < 5; attempt++ {
+		if wait := tr.backoff(attempt); wait != 0 {
+			t.Fatalf("expected retry %d to happen right away, got a backoff of %s", attempt+1, wait)
+		}
+	}
+}
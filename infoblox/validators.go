@@ -0,0 +1,423 @@
+package infoblox
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// The validators below are shared by the resource schemas, so that malformed
+// arguments are reported at plan time instead of as WAPI errors on apply.
+
+// maxTTL is the largest TTL allowed by RFC 2181.
+const maxTTL = 2147483647
+
+var (
+	// dnsLabelRegexp matches a single label of a domain name. Underscores
+	// are allowed as they are common in SRV and TXT record names.
+	dnsLabelRegexp = regexp.MustCompile(`^[A-Za-z0-9_]([A-Za-z0-9_-]{0,61}[A-Za-z0-9_])?$`)
+
+	// duidRegexp matches a DHCPv6 unique identifier written as colon
+	// separated hex octets.
+	duidRegexp = regexp.MustCompile(`^[0-9A-Fa-f]{1,2}(:[0-9A-Fa-f]{1,2})+$`)
+
+	// caaTagRegexp matches the property tag of a CAA record, which RFC 8659
+	// limits to 15 ASCII letters and digits.
+	caaTagRegexp = regexp.MustCompile(`^[A-Za-z0-9]{1,15}$`)
+
+	// naptrFlagsRegexp and naptrServicesRegexp match the flags and services
+	// of a NAPTR record as defined by RFC 3403, e.g. "U" and "E2U+sip".
+	naptrFlagsRegexp    = regexp.MustCompile(`^[A-Za-z0-9]*$`)
+	naptrServicesRegexp = regexp.MustCompile(`^[A-Za-z0-9+:.-]*$`)
+
+	// tlsaNameRegexp matches the name of a TLSA record, which starts with the
+	// port and protocol of the service, e.g. _443._tcp.www.example.com.
+	tlsaNameRegexp = regexp.MustCompile(`^_([0-9]{1,5})\._(tcp|udp|sctp)(\.(.+))?$`)
+
+	hexRegexp = regexp.MustCompile(`^([0-9A-Fa-f]{2})+$`)
+)
+
+// aliasTargetTypes lists the record types an alias record can point at.
+var aliasTargetTypes = []string{"A", "AAAA", "MX", "NAPTR", "PTR", "SPF", "SRV", "TXT"}
+
+func validateIPv4Address(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if ip := net.ParseIP(value); ip == nil || ip.To4() == nil {
+		errors = append(errors, fmt.Errorf("%q must be an IPv4 address, got %q", k, value))
+	}
+	return
+}
+
+func validateIPv6Address(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if ip := net.ParseIP(value); ip == nil || ip.To4() != nil {
+		errors = append(errors, fmt.Errorf("%q must be an IPv6 address, got %q", k, value))
+	}
+	return
+}
+
+func validateIPAddress(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if net.ParseIP(value) == nil {
+		errors = append(errors, fmt.Errorf("%q must be an IPv4 or IPv6 address, got %q", k, value))
+	}
+	return
+}
+
+// validateCIDR checks for an IPv4 or IPv6 network in CIDR notation, such as
+// 10.0.0.0/24. Addresses with host bits set are rejected, as WAPI does.
+func validateCIDR(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	ip, network, err := net.ParseCIDR(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a network in CIDR notation, got %q", k, value))
+		return
+	}
+	if !ip.Equal(network.IP) {
+		errors = append(errors, fmt.Errorf("%q must be a network address, got %q, did you mean %q?", k, value, network))
+	}
+	return
+}
+
+// validateIPRange checks for either an "<start>-<end>" address range or the
+// WAPI object reference of a DHCP range.
+func validateIPRange(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if strings.HasPrefix(value, "range/") || strings.HasPrefix(value, "ipv6range/") {
+		return
+	}
+
+	bounds := strings.Split(value, "-")
+	if len(bounds) != 2 || net.ParseIP(bounds[0]) == nil || net.ParseIP(bounds[1]) == nil {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a WAPI range reference or of the form <start>-<end>, got %q", k, value))
+	}
+	return
+}
+
+// validateAddressMatch checks the address of an access control entry, which
+// is either an address, a network in CIDR notation or "Any".
+func validateAddressMatch(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value == "Any" || net.ParseIP(value) != nil {
+		return
+	}
+	if _, _, err := net.ParseCIDR(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be an address, a network in CIDR notation or Any, got %q", k, value))
+	}
+	return
+}
+
+// isFQDN reports whether name is a valid domain name, optionally ending
+// with the root label.
+func isFQDN(name string) bool {
+	name = strings.TrimSuffix(name, ".")
+	if name == "" || len(name) > 253 {
+		return false
+	}
+	for _, label := range strings.Split(name, ".") {
+		if !dnsLabelRegexp.MatchString(label) {
+			return false
+		}
+	}
+	return true
+}
+
+func validateFQDN(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !isFQDN(value) {
+		errors = append(errors, fmt.Errorf("%q must be a fully qualified domain name, got %q", k, value))
+	}
+	return
+}
+
+// validateHostName checks the name of a host or PTR record, which is either
+// a domain name or "@" for the apex of the record's zone.
+func validateHostName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value != "@" && !isFQDN(value) {
+		errors = append(errors, fmt.Errorf("%q must be a fully qualified domain name or @, got %q", k, value))
+	}
+	return
+}
+
+// validateRecordName checks the name of a DNS record, which unlike host
+// names may be a wildcard such as *.example.com.
+func validateRecordName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	name := value
+	if name == "*" || name == "@" {
+		return
+	}
+	if strings.HasPrefix(name, "*.") {
+		name = strings.TrimPrefix(name, "*.")
+	}
+	if !isFQDN(name) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a fully qualified domain name, optionally starting with a *. wildcard, got %q", k, value))
+	}
+	return
+}
+
+// validateSharedRecordName checks the name of a shared record, which is
+// relative to the zones of its shared record group: "@" for their apex, or a
+// name such as www or *.dev in front of them.
+func validateSharedRecordName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value == "*" || value == "@" {
+		return
+	}
+	if strings.HasSuffix(value, ".") || !isFQDN(strings.TrimPrefix(value, "*.")) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a name relative to the zones of the shared record group, such as www, *.dev or @, got %q", k, value))
+	}
+	return
+}
+
+// validateZoneName checks the fqdn of an authoritative zone, which is a
+// domain name for forward zones and a network in CIDR notation for reverse
+// zones.
+func validateZoneName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if isFQDN(value) {
+		return
+	}
+	if _, _, err := net.ParseCIDR(value); err != nil {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a fully qualified domain name or, for reverse zones, a network in CIDR notation, got %q", k, value))
+	}
+	return
+}
+
+// validateViewName checks the name of a DNS or network view. Names are free
+// form, but import IDs separate them from the rest of the ID with a slash.
+func validateViewName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value == "" || strings.Contains(value, "/") || strings.TrimSpace(value) != value {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a non-empty name without slashes or surrounding spaces, got %q", k, value))
+	}
+	return
+}
+
+func validateMACAddress(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if mac, err := net.ParseMAC(value); err != nil || len(mac) != 6 {
+		errors = append(errors, fmt.Errorf("%q must be a MAC address such as 00:11:22:33:44:55, got %q", k, value))
+	}
+	return
+}
+
+func validateDUID(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !duidRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be a DUID of colon separated hex octets, got %q", k, value))
+	}
+	return
+}
+
+// validateUint16 checks the 16 bit unsigned integers of DNS records, such as
+// the preference of MX records and the priority, weight and port of SRV
+// records.
+func validateUint16(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+	if value < 0 || value > 65535 {
+		errors = append(errors, fmt.Errorf("%q must be between 0 and 65535, got %d", k, value))
+	}
+	return
+}
+
+func validateTTL(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+	if value < 0 || value > maxTTL {
+		errors = append(errors, fmt.Errorf("%q must be between 0 and %d, got %d", k, maxTTL, value))
+	}
+	return
+}
+
+// validateTTLString checks the TTL of the deprecated infoblox_record
+// resource, which is a string holding a number.
+func validateTTLString(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	ttl, err := strconv.Atoi(value)
+	if err != nil || ttl < 0 || ttl > maxTTL {
+		errors = append(errors, fmt.Errorf("%q must be a number between 0 and %d, got %q", k, maxTTL, value))
+	}
+	return
+}
+
+// validateIntBetween returns a validator for integers between min and max,
+// inclusive, such as the single octet fields of TLSA records.
+func validateIntBetween(min, max int) func(interface{}, string) ([]string, []error) {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(int)
+		if value < min || value > max {
+			errors = append(errors, fmt.Errorf("%q must be between %d and %d, got %d", k, min, max, value))
+		}
+		return
+	}
+}
+
+// validateStringIn returns a validator for strings which must be one of
+// values, such as the options of the descendants action of extensible
+// attributes.
+func validateStringIn(values ...string) func(interface{}, string) ([]string, []error) {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(string)
+		for _, allowed := range values {
+			if value == allowed {
+				return
+			}
+		}
+		errors = append(errors, fmt.Errorf("%q must be one of %s, got %q", k, strings.Join(values, ", "), value))
+		return
+	}
+}
+
+// validateCAAFlag checks the flag of a CAA record, which is either 0 or 128
+// when the issuer critical bit is set.
+func validateCAAFlag(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+	if value != 0 && value != 128 {
+		errors = append(errors, fmt.Errorf("%q must be 0 or 128, got %d", k, value))
+	}
+	return
+}
+
+func validateCAATag(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !caaTagRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a tag of up to 15 letters and digits such as issue, issuewild or iodef, got %q", k, value))
+	}
+	return
+}
+
+// validateCAAValueText checks the part of a CAA record value which doesn't
+// depend on its tag: RFC 8659 values are printable ASCII, and an empty value
+// is never meaningful. The format expected by the tag is only checked when
+// the record is written, as a ValidateFunc can't see the ca_tag.
+func validateCAAValueText(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	printable := value != ""
+	for _, r := range value {
+		if (r < 0x20 || r > 0x7e) && r != '\t' {
+			printable = false
+			break
+		}
+	}
+	if !printable {
+		errors = append(errors, fmt.Errorf("%q must be a non-empty string of printable ASCII characters, got %q", k, value))
+	}
+	return
+}
+
+func validateNAPTRFlags(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !naptrFlagsRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must consist of letters and digits such as U, S, A or P, got %q", k, value))
+	}
+	return
+}
+
+func validateNAPTRServices(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !naptrServicesRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be a service such as E2U+sip, got %q", k, value))
+	}
+	return
+}
+
+// validateNAPTRRegexp checks the substitution expression of a NAPTR record,
+// which RFC 3403 defines as <delim>pattern<delim>replacement<delim>[i], e.g.
+// !^.*$!sip:info@example.com!. An empty expression is allowed.
+func validateNAPTRRegexp(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value == "" {
+		return
+	}
+
+	delim := value[0]
+	if delim == '\\' || (delim >= '0' && delim <= '9') {
+		errors = append(errors, fmt.Errorf("%q must not use a backslash or digit as delimiter, got %q", k, value))
+		return
+	}
+
+	var delims int
+	flags := ""
+	for i := 1; i < len(value); i++ {
+		switch {
+		case value[i] == '\\':
+			i++
+		case value[i] == delim:
+			delims++
+			if delims == 2 {
+				flags = value[i+1:]
+				i = len(value)
+			}
+		}
+	}
+	if delims != 2 || (flags != "" && flags != "i") {
+		errors = append(errors, fmt.Errorf(
+			"%q must be of the form <delim>pattern<delim>replacement<delim>[i], got %q", k, value))
+	}
+	return
+}
+
+// validateNAPTRReplacement checks the replacement of a NAPTR record, which
+// is either a domain name or "." when the record uses a regexp instead.
+func validateNAPTRReplacement(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value != "." && !isFQDN(value) {
+		errors = append(errors, fmt.Errorf("%q must be a fully qualified domain name or ., got %q", k, value))
+	}
+	return
+}
+
+// validateTLSAName checks the name of a TLSA record, which starts with the
+// port and protocol of the service. The rest of the name may be left off for
+// services at the apex of the record's zone.
+func validateTLSAName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	m := tlsaNameRegexp.FindStringSubmatch(value)
+	if m == nil || (m[4] != "" && !isFQDN(m[4])) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be of the form _<port>._<protocol>[.<name>] such as _443._tcp.www.example.com, got %q", k, value))
+		return
+	}
+	if port, _ := strconv.Atoi(m[1]); port > 65535 {
+		errors = append(errors, fmt.Errorf("%q must have a port between 0 and 65535, got %q", k, value))
+	}
+	return
+}
+
+func validateHex(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !hexRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be a string of hex octets, got %q", k, value))
+	}
+	return
+}
+
+func validateAliasTargetType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	for _, t := range aliasTargetTypes {
+		if value == t {
+			return
+		}
+	}
+	errors = append(errors, fmt.Errorf("%q must be one of %s, got %q", k, strings.Join(aliasTargetTypes, ", "), value))
+	return
+}
+
+// validateLegacyRecordType checks the type of the deprecated infoblox_record
+// resource, which may be given in any case.
+func validateLegacyRecordType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if _, ok := legacyRecordTypes[strings.ToUpper(value)]; !ok {
+		errors = append(errors, fmt.Errorf("%q must be one of A, AAAA or CNAME, got %q", k, value))
+	}
+	return
+}
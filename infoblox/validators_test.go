@@ -0,0 +1,137 @@
+package infoblox
+
+import (
+	"testing"
+)
+
+func TestValidators(t *testing.T) {
+	cases := []struct {
+		Name        string
+		Validate    func(interface{}, string) ([]string, []error)
+		Value       interface{}
+		ExpectError bool
+	}{
+		{"ipv4", validateIPv4Address, "10.0.0.1", false},
+		{"ipv4", validateIPv4Address, "2001:db8::1", true},
+		{"ipv4", validateIPv4Address, "10.0.0.256", true},
+		{"ipv6", validateIPv6Address, "2001:db8::1", false},
+		{"ipv6", validateIPv6Address, "10.0.0.1", true},
+		{"ip", validateIPAddress, "10.0.0.1", false},
+		{"ip", validateIPAddress, "2001:db8::1", false},
+		{"ip", validateIPAddress, "www.example.com", true},
+
+		{"cidr", validateCIDR, "10.0.0.0/24", false},
+		{"cidr", validateCIDR, "2001:db8::/64", false},
+		{"cidr", validateCIDR, "10.0.0.1/24", true},
+		{"cidr", validateCIDR, "10.0.0.0", true},
+
+		{"range", validateIPRange, "10.0.0.10-10.0.0.20", false},
+		{"range", validateIPRange, "range/ZG5zLmRoY3BfcmFuZ2Uk:10.0.0.10/10.0.0.20/default", false},
+		{"range", validateIPRange, "10.0.0.10", true},
+		{"range", validateIPRange, "10.0.0.10-foo", true},
+
+		{"address match", validateAddressMatch, "Any", false},
+		{"address match", validateAddressMatch, "10.0.0.1", false},
+		{"address match", validateAddressMatch, "10.0.0.0/8", false},
+		{"address match", validateAddressMatch, "any host", true},
+
+		{"fqdn", validateFQDN, "www.example.com", false},
+		{"fqdn", validateFQDN, "www.example.com.", false},
+		{"fqdn", validateFQDN, "_sip._tcp.example.com", false},
+		{"fqdn", validateFQDN, "*.example.com", true},
+		{"fqdn", validateFQDN, "-www.example.com", true},
+		{"fqdn", validateFQDN, "www..example.com", true},
+		{"fqdn", validateFQDN, "", true},
+
+		{"record name", validateRecordName, "*.example.com", false},
+		{"record name", validateRecordName, "www.example.com", false},
+		{"record name", validateRecordName, "www.*.example.com", true},
+
+		{"shared record name", validateSharedRecordName, "www", false},
+		{"shared record name", validateSharedRecordName, "*.dev", false},
+		{"shared record name", validateSharedRecordName, "@", false},
+		{"shared record name", validateSharedRecordName, "www.example.com.", true},
+		{"shared record name", validateSharedRecordName, "www.*", true},
+
+		{"zone", validateZoneName, "example.com", false},
+		{"zone", validateZoneName, "10.0.0.0/24", false},
+		{"zone", validateZoneName, "example com", true},
+
+		{"mac", validateMACAddress, "00:11:22:33:44:55", false},
+		{"mac", validateMACAddress, "01-23-45-67-89-10", false},
+		{"mac", validateMACAddress, "00:11:22:33:44", true},
+		{"duid", validateDUID, "00:01:00:01:1d:2e:3f:40:00:11:22:33:44:66", false},
+		{"duid", validateDUID, "00-01-00-01", true},
+
+		{"uint16", validateUint16, 0, false},
+		{"uint16", validateUint16, 65535, false},
+		{"uint16", validateUint16, 65536, true},
+		{"uint16", validateUint16, -1, true},
+		{"ttl", validateTTL, 3600, false},
+		{"ttl", validateTTL, maxTTL + 1, true},
+		{"ttl", validateTTL, -1, true},
+
+		{"int between", validateIntBetween(0, 3), 3, false},
+		{"int between", validateIntBetween(0, 3), 4, true},
+
+		{"caa flag", validateCAAFlag, 0, false},
+		{"caa flag", validateCAAFlag, 128, false},
+		{"caa flag", validateCAAFlag, 1, true},
+		{"caa tag", validateCAATag, "issuewild", false},
+		{"caa tag", validateCAATag, "issue-wild", true},
+		{"caa tag", validateCAATag, "", true},
+		{"caa value", validateCAAValueText, "letsencrypt.org; validationmethods=dns-01", false},
+		{"caa value", validateCAAValueText, ";", false},
+		{"caa value", validateCAAValueText, "", true},
+		{"caa value", validateCAAValueText, "letsencrypt.org\n", true},
+
+		{"naptr flags", validateNAPTRFlags, "U", false},
+		{"naptr flags", validateNAPTRFlags, "", false},
+		{"naptr flags", validateNAPTRFlags, "U!", true},
+		{"naptr services", validateNAPTRServices, "E2U+sip", false},
+		{"naptr services", validateNAPTRServices, "E2U sip", true},
+		{"naptr regexp", validateNAPTRRegexp, "", false},
+		{"naptr regexp", validateNAPTRRegexp, "!^.*$!sip:info@example.com!", false},
+		{"naptr regexp", validateNAPTRRegexp, `!^\!(.*)$!sip:\1@example.com!i`, false},
+		{"naptr regexp", validateNAPTRRegexp, "!^.*$!sip:info@example.com", true},
+		{"naptr regexp", validateNAPTRRegexp, "!^.*$!sip:info@example.com!x", true},
+		{"naptr regexp", validateNAPTRRegexp, "1^.*1sip:info@example.com1", true},
+		{"naptr replacement", validateNAPTRReplacement, ".", false},
+		{"naptr replacement", validateNAPTRReplacement, "_sip._udp.example.com", false},
+		{"naptr replacement", validateNAPTRReplacement, "sip:info@example.com", true},
+
+		{"tlsa name", validateTLSAName, "_443._tcp.www.example.com", false},
+		{"tlsa name", validateTLSAName, "_25._tcp", false},
+		{"tlsa name", validateTLSAName, "www.example.com", true},
+		{"tlsa name", validateTLSAName, "_443._icmp.www.example.com", true},
+		{"tlsa name", validateTLSAName, "_70000._tcp.www.example.com", true},
+		{"hex", validateHex, "0a1B2c", false},
+		{"hex", validateHex, "0a1", true},
+		{"hex", validateHex, "0x0a", true},
+
+		{"alias target type", validateAliasTargetType, "AAAA", false},
+		{"alias target type", validateAliasTargetType, "CNAME", true},
+
+		{"legacy record type", validateLegacyRecordType, "cname", false},
+		{"legacy record type", validateLegacyRecordType, "MX", true},
+		{"ttl string", validateTTLString, "3600", false},
+		{"ttl string", validateTTLString, "1h", true},
+		{"ttl string", validateTTLString, "-1", true},
+
+		{"view name", validateViewName, "default", false},
+		{"view name", validateViewName, "Internal DNS", false},
+		{"view name", validateViewName, "", true},
+		{"view name", validateViewName, "corp/internal", true},
+		{"view name", validateViewName, " default", true},
+	}
+
+	for _, tc := range cases {
+		_, errors := tc.Validate(tc.Value, tc.Name)
+		if tc.ExpectError && len(errors) == 0 {
+			t.Fatalf("expected %s validation of %v to fail", tc.Name, tc.Value)
+		}
+		if !tc.ExpectError && len(errors) != 0 {
+			t.Fatalf("unexpected error validating %s %v: %s", tc.Name, tc.Value, errors)
+		}
+	}
+}
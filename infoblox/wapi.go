@@ -0,0 +1,177 @@
+package infoblox
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/url"
+	"sort"
+	"strings"
+
+	infoblox "github.com/fanatic/go-infoblox"
+)
+
+// go-infoblox only has dedicated support for a handful of WAPI object types.
+// The helpers in this file talk to the generic WAPI endpoints directly so that
+// we can manage any object type (fixed addresses, zones, ranges, ...) using
+// the same client, credentials and error type as the rest of the provider.
+
+// defaultWAPIVersion is the WAPI version used unless the provider is
+// configured with another one.
+const defaultWAPIVersion = "2.3"
+
+// wapiBasePath returns the path of the WAPI endpoints of the given version on
+// the grid master.
+func wapiBasePath(version string) string {
+	return "/wapi/v" + strings.TrimPrefix(version, "v") + "/"
+}
+
+// wapiRequest sends a request to WAPI and decodes the JSON response into out.
+// path is either an object type such as "fixedaddress" or an object reference.
+// WAPI errors are returned as an infoblox.Error so that callers can inspect the
+// error code, e.g. in handleReadError.
+func wapiRequest(client *infoblox.Client, method, path string, query url.Values, body interface{}, out interface{}) error {
+	// go-infoblox builds the URLs of its own requests from BasePath, so we
+	// use it here too to talk to the same WAPI version.
+	return wapiRequestAt(client, infoblox.BasePath, method, path, query, body, out)
+}
+
+// wapiRequestAt is wapiRequest for the WAPI endpoints below basePath.
+func wapiRequestAt(client *infoblox.Client, basePath, method, path string, query url.Values, body interface{}, out interface{}) error {
+	urlStr := basePath + path
+	if len(query) > 0 {
+		urlStr += "?" + query.Encode()
+	}
+
+	var payload string
+	head := map[string]string{}
+	if body != nil {
+		j, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		payload = string(j)
+		head["Content-Type"] = "application/json"
+	}
+
+	resp, err := client.SendRequest(method, urlStr, payload, head)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode >= 400 {
+		e := infoblox.Error{}
+		if err := json.Unmarshal(data, &e); err != nil {
+			return fmt.Errorf("%s %s failed with status %d: %s", method, path, resp.StatusCode, string(data))
+		}
+		return e
+	}
+
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(data, out)
+}
+
+func returnFieldsQuery(returnFields []string) url.Values {
+	query := url.Values{}
+	if len(returnFields) > 0 {
+		query.Set("_return_fields", strings.Join(returnFields, ","))
+	}
+	return query
+}
+
+// wapiCreate creates an object of the given type and returns its reference.
+func wapiCreate(client *infoblox.Client, objectType string, body map[string]interface{}) (string, error) {
+	log.Printf("[DEBUG] Creating WAPI %s object: %#v", objectType, body)
+
+	var ref string
+	err := wapiRequest(client, "POST", objectType, nil, body, &ref)
+	return ref, err
+}
+
+// wapiGet fetches the object with the given reference.
+func wapiGet(client *infoblox.Client, ref string, returnFields []string) (map[string]interface{}, error) {
+	var object map[string]interface{}
+	err := wapiRequest(client, "GET", ref, returnFieldsQuery(returnFields), nil, &object)
+	return object, err
+}
+
+// wapiFind searches for objects of the given type. conditions maps WAPI search
+// fields, optionally including a modifier such as "name~", to their values.
+func wapiFind(client *infoblox.Client, objectType string, conditions map[string]string, returnFields []string) ([]map[string]interface{}, error) {
+	query := returnFieldsQuery(returnFields)
+
+	// Sort the fields so the generated URLs are stable, which makes debug
+	// logs much easier to compare.
+	fields := make([]string, 0, len(conditions))
+	for field := range conditions {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		query.Add(field, conditions[field])
+	}
+
+	var objects []map[string]interface{}
+	err := wapiRequest(client, "GET", objectType, query, nil, &objects)
+	return objects, err
+}
+
+// wapiUpdate updates the object with the given reference and returns its
+// (possibly changed) reference.
+func wapiUpdate(client *infoblox.Client, ref string, body map[string]interface{}) (string, error) {
+	log.Printf("[DEBUG] Updating WAPI object %s: %#v", ref, body)
+
+	var newRef string
+	err := wapiRequest(client, "PUT", ref, nil, body, &newRef)
+	return newRef, err
+}
+
+// wapiDelete deletes the object with the given reference.
+func wapiDelete(client *infoblox.Client, ref string) error {
+	log.Printf("[DEBUG] Deleting WAPI object %s", ref)
+
+	return wapiRequest(client, "DELETE", ref, nil, nil, nil)
+}
+
+// wapiFunction calls a WAPI function such as next_available_ip on the object
+// with the given reference.
+func wapiFunction(client *infoblox.Client, ref, function string, body map[string]interface{}) (map[string]interface{}, error) {
+	query := url.Values{}
+	query.Set("_function", function)
+
+	var result map[string]interface{}
+	err := wapiRequest(client, "POST", ref, query, body, &result)
+	return result, err
+}
+
+// checkWAPIVersion verifies that the grid supports the WAPI version in use by
+// requesting the WAPI schema, which lists the supported versions.
+func checkWAPIVersion(client *infoblox.Client, version string) error {
+	version = strings.TrimPrefix(version, "v")
+
+	var result struct {
+		SupportedVersions []string `json:"supported_versions"`
+	}
+	query := url.Values{}
+	query.Set("_schema", "")
+	if err := wapiRequestAt(client, wapiBasePath(version), "GET", "", query, nil, &result); err != nil {
+		return fmt.Errorf("unable to use WAPI version %s on the Infoblox grid: %s", version, err)
+	}
+
+	for _, v := range result.SupportedVersions {
+		if v == version {
+			return nil
+		}
+	}
+	return fmt.Errorf("the Infoblox grid does not support WAPI version %s, supported versions are: %s",
+		version, strings.Join(result.SupportedVersions, ", "))
+}